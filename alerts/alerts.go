@@ -0,0 +1,213 @@
+// Package alerts evaluates user-defined market conditions (price levels,
+// spreads, daily change) against tickers and delivers triggers over
+// channels or callbacks.
+package alerts
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	nobitex "github.com/darhelm/go-nobitex"
+	t "github.com/darhelm/go-nobitex/types"
+)
+
+// Alert is a condition watched on one market.
+type Alert struct {
+	// Market is the ticker key, e.g. "btc-rls".
+	Market string
+
+	// Condition decides when the alert fires.
+	Condition Condition
+
+	// Once removes the alert after its first trigger.
+	Once bool
+
+	// Cooldown suppresses repeated triggers of a level-style condition
+	// (e.g. PriceAbove) for this long after each trigger.
+	Cooldown time.Duration
+}
+
+// Trigger is delivered when an alert fires.
+type Trigger struct {
+	// ID is the id returned by Manager.Add.
+	ID int
+
+	// Alert is the alert that fired.
+	Alert Alert
+
+	// Ticker is the ticker update that satisfied the condition.
+	Ticker t.Ticker
+
+	// At is when the update was evaluated.
+	At time.Time
+}
+
+// TickerSource provides ticker snapshots. *nobitex.Client implements it.
+type TickerSource interface {
+	GetTickers(params t.GetTickersParams, opts ...nobitex.RequestOption) (*t.Tickers, error)
+}
+
+// registered is an alert with its delivery state.
+type registered struct {
+	alert     Alert
+	lastFired time.Time
+}
+
+// Manager holds alerts and evaluates them against ticker updates. It is
+// safe for concurrent use.
+//
+// Example:
+//
+//	m := alerts.NewManager()
+//	m.Add(alerts.Alert{Market: "btc-rls", Condition: alerts.PriceCrosses(7e9)})
+//	m.Add(alerts.Alert{Market: "usdt-rls", Condition: alerts.SpreadAbove(50), Cooldown: time.Minute})
+//
+//	go m.Poll(ctx, client, 5*time.Second, nil)
+//	for trig := range m.Subscribe(ctx) {
+//	    fmt.Println(trig.Alert.Market, trig.Alert.Condition, trig.Ticker.Latest)
+//	}
+type Manager struct {
+	mu          sync.Mutex
+	nextID      int
+	alerts      map[int]*registered
+	last        map[string]t.Ticker
+	subscribers map[chan Trigger]struct{}
+	callbacks   []func(Trigger)
+}
+
+// NewManager returns an empty Manager.
+func NewManager() *Manager {
+	return &Manager{
+		alerts:      make(map[int]*registered),
+		last:        make(map[string]t.Ticker),
+		subscribers: make(map[chan Trigger]struct{}),
+	}
+}
+
+// Add registers alert and returns its id.
+func (m *Manager) Add(alert Alert) int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	alert.Market = strings.ToLower(alert.Market)
+	m.nextID++
+	m.alerts[m.nextID] = &registered{alert: alert}
+	return m.nextID
+}
+
+// Remove unregisters the alert with id.
+func (m *Manager) Remove(id int) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delete(m.alerts, id)
+}
+
+// OnTrigger registers fn to be called synchronously for every trigger.
+func (m *Manager) OnTrigger(fn func(Trigger)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.callbacks = append(m.callbacks, fn)
+}
+
+// Subscribe returns a channel receiving every trigger until ctx is done.
+// The channel is buffered; triggers are dropped for a subscriber whose
+// buffer is full rather than blocking evaluation.
+func (m *Manager) Subscribe(ctx context.Context) <-chan Trigger {
+	ch := make(chan Trigger, 64)
+
+	m.mu.Lock()
+	m.subscribers[ch] = struct{}{}
+	m.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+		m.mu.Lock()
+		delete(m.subscribers, ch)
+		close(ch)
+		m.mu.Unlock()
+	}()
+
+	return ch
+}
+
+// Update evaluates every alert of market against ticker.
+func (m *Manager) Update(market string, ticker t.Ticker, at time.Time) {
+	market = strings.ToLower(market)
+
+	m.mu.Lock()
+	prev, hasPrev := m.last[market]
+	m.last[market] = ticker
+
+	var fired []Trigger
+	for id, reg := range m.alerts {
+		if reg.alert.Market != market || reg.alert.Condition == nil {
+			continue
+		}
+		if reg.alert.Cooldown > 0 && !reg.lastFired.IsZero() && at.Sub(reg.lastFired) < reg.alert.Cooldown {
+			continue
+		}
+		if !reg.alert.Condition.Check(prev, ticker, hasPrev) {
+			continue
+		}
+
+		reg.lastFired = at
+		fired = append(fired, Trigger{ID: id, Alert: reg.alert, Ticker: ticker, At: at})
+		if reg.alert.Once {
+			delete(m.alerts, id)
+		}
+	}
+
+	for _, trig := range fired {
+		for ch := range m.subscribers {
+			select {
+			case ch <- trig:
+			default:
+			}
+		}
+	}
+	callbacks := append([]func(Trigger){}, m.callbacks...)
+	m.mu.Unlock()
+
+	for _, trig := range fired {
+		for _, fn := range callbacks {
+			fn(trig)
+		}
+	}
+}
+
+// UpdateTickers evaluates a full /market/stats snapshot.
+func (m *Manager) UpdateTickers(tickers *t.Tickers, at time.Time) {
+	for market, ticker := range tickers.Stats {
+		m.Update(market, ticker, at)
+	}
+}
+
+// Poll fetches tickers from source every interval and evaluates them until
+// ctx is done, then returns ctx.Err(). Fetch errors are passed to onError
+// (if non-nil) and polling continues.
+func (m *Manager) Poll(ctx context.Context, source TickerSource, interval time.Duration, onError func(error)) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		tickers, err := source.GetTickers(t.GetTickersParams{}, nobitex.WithContext(ctx))
+		if err != nil {
+			if onError != nil && ctx.Err() == nil {
+				onError(fmt.Errorf("alerts: fetching tickers: %w", err))
+			}
+		} else {
+			m.UpdateTickers(tickers, time.Now())
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,134 @@
+package alerts
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+
+	t "github.com/darhelm/go-nobitex/types"
+)
+
+// Condition decides whether an alert fires for a ticker update.
+type Condition interface {
+	// Check reports whether the condition holds for curr. prev is the
+	// previous ticker of the same market; hasPrev is false on the first
+	// update.
+	Check(prev, curr t.Ticker, hasPrev bool) bool
+
+	// String describes the condition for logs and notifications.
+	String() string
+}
+
+// PriceAbove fires while the latest price is above level.
+func PriceAbove(level float64) Condition {
+	return priceCondition{level: level, above: true}
+}
+
+// PriceBelow fires while the latest price is below level.
+func PriceBelow(level float64) Condition {
+	return priceCondition{level: level}
+}
+
+// PriceCrosses fires on the update where the latest price moves from one
+// side of level to the other, in either direction.
+func PriceCrosses(level float64) Condition {
+	return crossCondition{level: level}
+}
+
+// SpreadAbove fires while the best bid/ask spread exceeds bps basis points
+// of the mid price.
+func SpreadAbove(bps float64) Condition {
+	return spreadCondition{bps: bps}
+}
+
+// DayChangeAbove fires while the absolute 24h change exceeds percent
+// (5 = 5%).
+func DayChangeAbove(percent float64) Condition {
+	return dayChangeCondition{percent: percent}
+}
+
+type priceCondition struct {
+	level float64
+	above bool
+}
+
+func (c priceCondition) Check(_, curr t.Ticker, _ bool) bool {
+	price, ok := parse(curr.Latest)
+	if !ok {
+		return false
+	}
+	if c.above {
+		return price > c.level
+	}
+	return price < c.level
+}
+
+func (c priceCondition) String() string {
+	if c.above {
+		return fmt.Sprintf("price > %v", c.level)
+	}
+	return fmt.Sprintf("price < %v", c.level)
+}
+
+type crossCondition struct {
+	level float64
+}
+
+func (c crossCondition) Check(prev, curr t.Ticker, hasPrev bool) bool {
+	if !hasPrev {
+		return false
+	}
+	before, ok := parse(prev.Latest)
+	if !ok {
+		return false
+	}
+	after, ok := parse(curr.Latest)
+	if !ok {
+		return false
+	}
+	return (before < c.level && after >= c.level) || (before > c.level && after <= c.level)
+}
+
+func (c crossCondition) String() string {
+	return fmt.Sprintf("price crosses %v", c.level)
+}
+
+type spreadCondition struct {
+	bps float64
+}
+
+func (c spreadCondition) Check(_, curr t.Ticker, _ bool) bool {
+	bid, ok := parse(curr.BestBuy)
+	if !ok {
+		return false
+	}
+	ask, ok := parse(curr.BestSell)
+	if !ok {
+		return false
+	}
+	mid := (bid + ask) / 2
+	return mid > 0 && (ask-bid)/mid*10_000 > c.bps
+}
+
+func (c spreadCondition) String() string {
+	return fmt.Sprintf("spread > %v bps", c.bps)
+}
+
+type dayChangeCondition struct {
+	percent float64
+}
+
+func (c dayChangeCondition) Check(_, curr t.Ticker, _ bool) bool {
+	change, ok := parse(curr.DayChange)
+	return ok && math.Abs(change) > c.percent
+}
+
+func (c dayChangeCondition) String() string {
+	return fmt.Sprintf("|24h change| > %v%%", c.percent)
+}
+
+// parse reads a numeric ticker field.
+func parse(value string) (float64, bool) {
+	v, err := strconv.ParseFloat(value, 64)
+	return v, err == nil
+}
@@ -0,0 +1,36 @@
+package alerts
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/darhelm/go-nobitex/notify"
+)
+
+// TriggerEvent converts a trigger into an "alert.triggered" notification
+// whose Data is the Trigger itself.
+func TriggerEvent(trig Trigger) notify.Event {
+	return notify.Event{
+		Kind:    "alert.triggered",
+		Subject: fmt.Sprintf("%s: %s", trig.Alert.Market, trig.Alert.Condition),
+		Message: fmt.Sprintf("latest %s, bid %s, ask %s", trig.Ticker.Latest, trig.Ticker.BestBuy, trig.Ticker.BestSell),
+		Data:    trig,
+		At:      trig.At,
+	}
+}
+
+// Notify delivers every trigger to n until ctx is done, then returns
+// ctx.Err(). Delivery failures are passed to onError (if non-nil).
+//
+// Example:
+//
+//	go m.Poll(ctx, client, 5*time.Second, nil)
+//	go m.Notify(ctx, telegram, func(err error) { log.Println(err) })
+func (m *Manager) Notify(ctx context.Context, n notify.Notifier, onError func(error)) error {
+	for trig := range m.Subscribe(ctx) {
+		if err := n.Notify(ctx, TriggerEvent(trig)); err != nil && onError != nil {
+			onError(fmt.Errorf("alerts: notify: %w", err))
+		}
+	}
+	return ctx.Err()
+}
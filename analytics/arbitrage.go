@@ -0,0 +1,197 @@
+package analytics
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	nobitex "github.com/darhelm/go-nobitex"
+	t "github.com/darhelm/go-nobitex/types"
+)
+
+// DefaultScanInterval is how often an ArbitrageScanner polls tickers when
+// no interval is configured.
+const DefaultScanInterval = 2 * time.Second
+
+// TickerSource provides ticker snapshots. *nobitex.Client implements it.
+type TickerSource interface {
+	GetTickers(params t.GetTickersParams, opts ...nobitex.RequestOption) (*t.Tickers, error)
+}
+
+// Opportunity is a conversion cycle whose round trip yields more than it
+// costs after fees.
+type Opportunity struct {
+	// Cycle is the currency path, starting and ending with the same
+	// currency, e.g. ["usdt", "btc", "rls", "usdt"].
+	Cycle []string
+
+	// Spread is the relative gain of one round trip after fees
+	// (0.002 = 0.2%).
+	Spread float64
+
+	// DetectedAt is when the snapshot that produced it was evaluated.
+	DetectedAt time.Time
+}
+
+// AssetCycles returns both directions of the USDT/rial cycle for asset,
+// which capture the price gap between its USDT and IRT markets.
+//
+// Example:
+//
+//	analytics.AssetCycles("btc")
+//	// [["usdt" "btc" "rls" "usdt"] ["usdt" "rls" "btc" "usdt"]]
+func AssetCycles(asset string) [][]string {
+	asset = strings.ToLower(asset)
+	return [][]string{
+		{"usdt", asset, "rls", "usdt"},
+		{"usdt", "rls", asset, "usdt"},
+	}
+}
+
+// EvaluateCycle returns the relative gain of converting one unit of
+// cycle[0] along cycle at the best executable prices of tickers, paying
+// fee (a fraction, e.g. 0.0025) on every leg.
+//
+// Each leg X→Y sells X at the best bid of market X-Y or buys Y at the best
+// ask of market Y-X, whichever market exists.
+func EvaluateCycle(tickers *t.Tickers, cycle []string, fee float64) (float64, error) {
+	if len(cycle) < 3 || cycle[0] != cycle[len(cycle)-1] {
+		return 0, fmt.Errorf("cycle must start and end with the same currency: %v", cycle)
+	}
+
+	amount := 1.0
+	for i := 0; i+1 < len(cycle); i++ {
+		rate, err := legRate(tickers, cycle[i], cycle[i+1])
+		if err != nil {
+			return 0, err
+		}
+		amount *= rate * (1 - fee)
+	}
+	return amount - 1, nil
+}
+
+// legRate returns how much of dst one unit of src converts to.
+func legRate(tickers *t.Tickers, src, dst string) (float64, error) {
+	if ticker, ok := tickers.Stats[src+"-"+dst]; ok && !ticker.IsClosed {
+		bid, err := strconv.ParseFloat(ticker.BestBuy, 64)
+		if err == nil && bid > 0 {
+			return bid, nil
+		}
+	}
+	if ticker, ok := tickers.Stats[dst+"-"+src]; ok && !ticker.IsClosed {
+		ask, err := strconv.ParseFloat(ticker.BestSell, 64)
+		if err == nil && ask > 0 {
+			return 1 / ask, nil
+		}
+	}
+	return 0, fmt.Errorf("no open market between %s and %s", src, dst)
+}
+
+// ArbitrageScanner polls tickers and reports cycles whose after-fee spread
+// reaches MinSpread.
+//
+// Example:
+//
+//	scanner := &analytics.ArbitrageScanner{
+//	    Source:    client,
+//	    Cycles:    append(analytics.AssetCycles("btc"), analytics.AssetCycles("eth")...),
+//	    Fee:       0.0025,
+//	    MinSpread: 0.001,
+//	}
+//	for opp := range scanner.Run(ctx) {
+//	    fmt.Printf("%v: %.3f%%\n", opp.Cycle, opp.Spread*100)
+//	}
+type ArbitrageScanner struct {
+	// Source provides ticker snapshots.
+	Source TickerSource
+
+	// Cycles lists the conversion cycles to evaluate.
+	Cycles [][]string
+
+	// Fee is the fee fraction paid on each leg (taker fee).
+	Fee float64
+
+	// MinSpread is the smallest after-fee spread reported.
+	MinSpread float64
+
+	// Interval is the polling period. Defaults to DefaultScanInterval.
+	Interval time.Duration
+
+	// OnError, if set, receives ticker fetch and cycle evaluation errors.
+	// Scanning continues after an error.
+	OnError func(err error)
+}
+
+// Run starts scanning in a goroutine and returns the channel opportunities
+// are sent on. The channel is closed once ctx is done. Sends block, so a
+// slow consumer delays the next poll rather than losing opportunities.
+func (s *ArbitrageScanner) Run(ctx context.Context) <-chan Opportunity {
+	out := make(chan Opportunity)
+
+	interval := s.Interval
+	if interval <= 0 {
+		interval = DefaultScanInterval
+	}
+
+	go func() {
+		defer close(out)
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			for _, opp := range s.Scan(ctx) {
+				select {
+				case out <- opp:
+				case <-ctx.Done():
+					return
+				}
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+
+	return out
+}
+
+// Scan fetches one ticker snapshot and returns every cycle whose spread
+// reaches MinSpread.
+func (s *ArbitrageScanner) Scan(ctx context.Context) []Opportunity {
+	tickers, err := s.Source.GetTickers(t.GetTickersParams{}, nobitex.WithContext(ctx))
+	if err != nil {
+		s.report(err)
+		return nil
+	}
+
+	now := time.Now()
+	var opportunities []Opportunity
+	for _, cycle := range s.Cycles {
+		spread, err := EvaluateCycle(tickers, cycle, s.Fee)
+		if err != nil {
+			s.report(err)
+			continue
+		}
+		if spread >= s.MinSpread {
+			opportunities = append(opportunities, Opportunity{
+				Cycle:      cycle,
+				Spread:     spread,
+				DetectedAt: now,
+			})
+		}
+	}
+	return opportunities
+}
+
+// report forwards err to OnError, if set.
+func (s *ArbitrageScanner) report(err error) {
+	if s.OnError != nil {
+		s.OnError(err)
+	}
+}
@@ -0,0 +1,128 @@
+package analytics
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	t "github.com/darhelm/go-nobitex/types"
+)
+
+// ErrStaleTickers is returned when the tickers are older than the allowed age.
+var ErrStaleTickers = errors.New("tickers are stale")
+
+// DefaultBridges are the quote currencies tried, in order, when no direct
+// market exists between two currencies.
+var DefaultBridges = []string{"usdt", "rls"}
+
+// CrossRate is a derived price between two currencies.
+type CrossRate struct {
+	// Rate is the amount of the destination currency worth one unit of the
+	// source currency.
+	Rate float64
+
+	// Path lists the currencies traversed, e.g. ["eth", "usdt", "rls"].
+	Path []string
+}
+
+// CrossRates derives prices between any two currencies from a snapshot of
+// tickers. Many pairs only trade against one quote currency on Nobitex, so
+// missing markets are bridged through USDT or rial legs.
+//
+// Example:
+//
+//	stats, err := client.GetTickers(t.GetTickersParams{})
+//	if err != nil {
+//	    return err
+//	}
+//	rates := analytics.CrossRates{Tickers: stats, FetchedAt: time.Now(), MaxAge: 30 * time.Second}
+//	r, err := rates.Rate("ton", "rls")
+//	fmt.Println(r.Rate, r.Path)
+type CrossRates struct {
+	// Tickers is the /market/stats snapshot, keyed "src-dst" (e.g. "btc-rls").
+	Tickers *t.Tickers
+
+	// FetchedAt is when Tickers was retrieved.
+	FetchedAt time.Time
+
+	// MaxAge rejects snapshots older than this. Zero disables the check.
+	MaxAge time.Duration
+
+	// Bridges overrides DefaultBridges.
+	Bridges []string
+}
+
+// Rate returns the price of one unit of src expressed in dst. Currencies
+// use Nobitex symbols ("btc", "usdt", "rls").
+//
+// Behavior:
+//   - A direct market (src-dst) is preferred, then the inverse market
+//     (dst-src), then a two-leg path through each bridge currency.
+//   - Closed markets and markets without a positive latest price are
+//     ignored.
+//   - Returns ErrStaleTickers when the snapshot exceeds MaxAge.
+func (c CrossRates) Rate(src, dst string) (CrossRate, error) {
+	if c.Tickers == nil {
+		return CrossRate{}, errors.New("no tickers")
+	}
+	if c.MaxAge > 0 && time.Since(c.FetchedAt) > c.MaxAge {
+		return CrossRate{}, fmt.Errorf("%w: fetched %s ago", ErrStaleTickers, time.Since(c.FetchedAt).Round(time.Second))
+	}
+
+	src, dst = strings.ToLower(src), strings.ToLower(dst)
+	if src == dst {
+		return CrossRate{Rate: 1, Path: []string{src}}, nil
+	}
+
+	if rate, ok := c.pair(src, dst); ok {
+		return CrossRate{Rate: rate, Path: []string{src, dst}}, nil
+	}
+
+	bridges := c.Bridges
+	if bridges == nil {
+		bridges = DefaultBridges
+	}
+	for _, bridge := range bridges {
+		if bridge == src || bridge == dst {
+			continue
+		}
+		first, ok := c.pair(src, bridge)
+		if !ok {
+			continue
+		}
+		second, ok := c.pair(bridge, dst)
+		if !ok {
+			continue
+		}
+		return CrossRate{Rate: first * second, Path: []string{src, bridge, dst}}, nil
+	}
+
+	return CrossRate{}, fmt.Errorf("no market path from %s to %s", src, dst)
+}
+
+// pair returns the direct or inverted rate between two currencies.
+func (c CrossRates) pair(src, dst string) (float64, bool) {
+	if price, ok := c.latest(src + "-" + dst); ok {
+		return price, true
+	}
+	if price, ok := c.latest(dst + "-" + src); ok {
+		return 1 / price, true
+	}
+	return 0, false
+}
+
+// latest returns the last price of an open market.
+func (c CrossRates) latest(market string) (float64, bool) {
+	ticker, ok := c.Tickers.Stats[market]
+	if !ok || ticker.IsClosed {
+		return 0, false
+	}
+
+	price, err := strconv.ParseFloat(ticker.Latest, 64)
+	if err != nil || price <= 0 {
+		return 0, false
+	}
+	return price, true
+}
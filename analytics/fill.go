@@ -0,0 +1,117 @@
+package analytics
+
+import (
+	"fmt"
+
+	t "github.com/darhelm/go-nobitex/types"
+)
+
+// FillEstimate is the expected outcome of a market order walking the book.
+type FillEstimate struct {
+	// Filled is the base amount the visible depth can absorb, at most the
+	// requested amount.
+	Filled float64
+
+	// Cost is the quote-currency value of Filled (spent for buys,
+	// received for sells), before fees.
+	Cost float64
+
+	// AveragePrice is Cost / Filled.
+	AveragePrice float64
+
+	// BestPrice is the top-of-book price on the consumed side.
+	BestPrice float64
+
+	// WorstPrice is the price of the deepest level touched.
+	WorstPrice float64
+
+	// SlippageBps is how far AveragePrice is from BestPrice, in basis
+	// points, in the unfavorable direction.
+	SlippageBps float64
+
+	// Levels is the number of price levels consumed.
+	Levels int
+
+	// Sufficient reports whether the visible depth covers the whole amount.
+	Sufficient bool
+}
+
+// EstimateFill simulates a market order of amount (base currency) against
+// book, so market orders can be checked against an acceptable slippage
+// before being sent.
+//
+// Parameters:
+//   - book: An order book snapshot from GetOrderBook.
+//   - side: "buy" consumes asks, "sell" consumes bids.
+//   - amount: Base-currency quantity to fill; must be positive.
+//
+// Example:
+//
+//	book, _ := client.GetOrderBook("BTCUSDT")
+//	est, err := analytics.EstimateFill(book, "buy", 0.5)
+//	if err != nil {
+//	    return err
+//	}
+//	if !est.Sufficient || est.SlippageBps > 20 {
+//	    return errors.New("book too thin")
+//	}
+func EstimateFill(book *t.OrderBook, side string, amount float64) (FillEstimate, error) {
+	var raw [][]string
+	switch side {
+	case "buy":
+		raw = book.Asks
+	case "sell":
+		raw = book.Bids
+	default:
+		return FillEstimate{}, fmt.Errorf("invalid side %q (want \"buy\" or \"sell\")", side)
+	}
+
+	levels, err := ParseLevels(raw)
+	if err != nil {
+		return FillEstimate{}, err
+	}
+	return EstimateFillLevels(levels, side, amount)
+}
+
+// EstimateFillLevels is EstimateFill for an already parsed book side,
+// ordered best price first.
+func EstimateFillLevels(levels []Level, side string, amount float64) (FillEstimate, error) {
+	if amount <= 0 {
+		return FillEstimate{}, fmt.Errorf("amount must be positive, got %v", amount)
+	}
+	if len(levels) == 0 {
+		return FillEstimate{}, ErrEmptyBook
+	}
+
+	est := FillEstimate{BestPrice: levels[0].Price}
+	remaining := amount
+	for _, level := range levels {
+		if remaining <= 0 {
+			break
+		}
+		take := min(remaining, level.Amount)
+		if take <= 0 {
+			continue
+		}
+
+		est.Filled += take
+		est.Cost += take * level.Price
+		est.WorstPrice = level.Price
+		est.Levels++
+		remaining -= take
+	}
+
+	est.Sufficient = remaining <= 0
+	if est.Filled > 0 {
+		est.AveragePrice = est.Cost / est.Filled
+	}
+	if est.BestPrice > 0 && est.AveragePrice > 0 {
+		diff := est.AveragePrice - est.BestPrice
+		if side == "sell" {
+			diff = -diff
+		}
+		est.SlippageBps = diff / est.BestPrice * 10_000
+	}
+
+	return est, nil
+}
@@ -0,0 +1,185 @@
+package analytics
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	t "github.com/darhelm/go-nobitex/types"
+)
+
+// ErrEmptyBook is returned when a side of the order book has no levels.
+var ErrEmptyBook = errors.New("order book side is empty")
+
+// Level is a parsed order book price level.
+type Level struct {
+	Price  float64
+	Amount float64
+}
+
+// ParseLevels converts the [price, amount] string pairs of an OrderBook
+// side into Levels, preserving their order.
+func ParseLevels(levels [][]string) ([]Level, error) {
+	parsed := make([]Level, 0, len(levels))
+	for i, level := range levels {
+		if len(level) < 2 {
+			return nil, fmt.Errorf("level %d: expected [price, amount], got %d fields", i, len(level))
+		}
+
+		price, err := strconv.ParseFloat(level[0], 64)
+		if err != nil {
+			return nil, fmt.Errorf("level %d: invalid price %q: %w", i, level[0], err)
+		}
+		amount, err := strconv.ParseFloat(level[1], 64)
+		if err != nil {
+			return nil, fmt.Errorf("level %d: invalid amount %q: %w", i, level[1], err)
+		}
+
+		parsed = append(parsed, Level{Price: price, Amount: amount})
+	}
+	return parsed, nil
+}
+
+// BookMetrics summarizes the top of an order book snapshot.
+type BookMetrics struct {
+	// BestBid and BestAsk are the top-of-book prices.
+	BestBid float64
+	BestAsk float64
+
+	// Mid is the midpoint of BestBid and BestAsk.
+	Mid float64
+
+	// Spread is BestAsk - BestBid in quote currency.
+	Spread float64
+
+	// SpreadBps is Spread relative to Mid, in basis points.
+	SpreadBps float64
+
+	// Imbalance is (bid volume - ask volume) / (bid volume + ask volume)
+	// over the top Depth levels of each side, in [-1, 1]. Positive values
+	// indicate buy pressure.
+	Imbalance float64
+
+	// WeightedMid is the depth-weighted mid price over the top Depth
+	// levels: each side's volume-weighted price, weighted by the volume of
+	// the opposite side. It leans towards the thinner side of the book.
+	WeightedMid float64
+
+	// BidVolume and AskVolume are the base-currency volumes over the top
+	// Depth levels.
+	BidVolume float64
+	AskVolume float64
+
+	// Depth is the number of levels per side the metrics were computed
+	// over (capped at the available levels).
+	Depth int
+}
+
+// AnalyzeBook computes spread, imbalance and depth-weighted mid price over
+// the top depth levels of book. A depth of 0 or less uses every level.
+//
+// Nobitex lists bids best (highest) first and asks best (lowest) first.
+//
+// Example:
+//
+//	book, _ := client.GetOrderBook("BTCIRT")
+//	m, err := analytics.AnalyzeBook(book, 10)
+//	if err != nil {
+//	    return err
+//	}
+//	fmt.Printf("spread %.1f bps, imbalance %.2f\n", m.SpreadBps, m.Imbalance)
+func AnalyzeBook(book *t.OrderBook, depth int) (BookMetrics, error) {
+	bids, err := ParseLevels(book.Bids)
+	if err != nil {
+		return BookMetrics{}, fmt.Errorf("bids: %w", err)
+	}
+	asks, err := ParseLevels(book.Asks)
+	if err != nil {
+		return BookMetrics{}, fmt.Errorf("asks: %w", err)
+	}
+	return AnalyzeLevels(bids, asks, depth)
+}
+
+// AnalyzeLevels is AnalyzeBook for already parsed levels.
+func AnalyzeLevels(bids, asks []Level, depth int) (BookMetrics, error) {
+	if len(bids) == 0 || len(asks) == 0 {
+		return BookMetrics{}, ErrEmptyBook
+	}
+
+	m := BookMetrics{
+		BestBid: bids[0].Price,
+		BestAsk: asks[0].Price,
+	}
+	m.Mid = (m.BestBid + m.BestAsk) / 2
+	m.Spread = m.BestAsk - m.BestBid
+	if m.Mid > 0 {
+		m.SpreadBps = m.Spread / m.Mid * 10_000
+	}
+
+	bids, asks = topLevels(bids, depth), topLevels(asks, depth)
+	m.Depth = max(len(bids), len(asks))
+
+	bidVolume, bidNotional := sumLevels(bids)
+	askVolume, askNotional := sumLevels(asks)
+	m.BidVolume, m.AskVolume = bidVolume, askVolume
+
+	total := bidVolume + askVolume
+	if total > 0 {
+		m.Imbalance = (bidVolume - askVolume) / total
+	}
+
+	if bidVolume > 0 && askVolume > 0 {
+		bidVWAP := bidNotional / bidVolume
+		askVWAP := askNotional / askVolume
+		m.WeightedMid = (bidVWAP*askVolume + askVWAP*bidVolume) / total
+	} else {
+		m.WeightedMid = m.Mid
+	}
+
+	return m, nil
+}
+
+// SpreadBps returns the top-of-book spread of book in basis points.
+func SpreadBps(book *t.OrderBook) (float64, error) {
+	m, err := AnalyzeBook(book, 1)
+	if err != nil {
+		return 0, err
+	}
+	return m.SpreadBps, nil
+}
+
+// Imbalance returns the volume imbalance over the top n levels of book.
+func Imbalance(book *t.OrderBook, n int) (float64, error) {
+	m, err := AnalyzeBook(book, n)
+	if err != nil {
+		return 0, err
+	}
+	return m.Imbalance, nil
+}
+
+// WeightedMid returns the depth-weighted mid price over the top n levels
+// of book.
+func WeightedMid(book *t.OrderBook, n int) (float64, error) {
+	m, err := AnalyzeBook(book, n)
+	if err != nil {
+		return 0, err
+	}
+	return m.WeightedMid, nil
+}
+
+// topLevels returns the first n levels, or all of them when n <= 0.
+func topLevels(levels []Level, n int) []Level {
+	if n <= 0 || n >= len(levels) {
+		return levels
+	}
+	return levels[:n]
+}
+
+// sumLevels returns the total amount and price × amount of levels.
+func sumLevels(levels []Level) (volume, notional float64) {
+	for _, level := range levels {
+		volume += level.Amount
+		notional += level.Amount * level.Price
+	}
+	return volume, notional
+}
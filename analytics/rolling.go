@@ -0,0 +1,277 @@
+// Package analytics derives market metrics (rolling statistics, order book
+// spreads and depth, cross rates, fill estimates) from the data returned
+// by the Nobitex client.
+package analytics
+
+import (
+	"math"
+	"strconv"
+	"time"
+
+	t "github.com/darhelm/go-nobitex/types"
+)
+
+// observation is one price/volume point held in a rolling window.
+type observation struct {
+	at     time.Time
+	price  float64
+	volume float64
+
+	// ret is the log return from the previous observation; hasRet is false
+	// for the first observation ever added.
+	ret    float64
+	hasRet bool
+}
+
+// RollingStats maintains statistics over a sliding time window of trades
+// or ticker prices. Adding an observation is amortized O(1) and every query
+// is O(1), so screeners can update on each tick without recomputing.
+//
+// Observations must be added in non-decreasing time order; older ones are
+// ignored. RollingStats is not safe for concurrent use.
+//
+// Example:
+//
+//	stats := analytics.NewRollingStats(24 * time.Hour)
+//	trades, _ := client.GetRecentTrades("BTCIRT")
+//	// Recent trades are newest first; feed them oldest first
+//	for i := len(trades.Trades) - 1; i >= 0; i-- {
+//	    stats.AddTrade(trades.Trades[i])
+//	}
+//	fmt.Println(stats.Volume(), stats.High(), stats.Low(), stats.Volatility())
+type RollingStats struct {
+	window time.Duration
+
+	// items is a FIFO queue of observations; head indexes its front.
+	items []observation
+	head  int
+
+	volume   float64
+	notional float64
+	retSum   float64
+	retSqSum float64
+	retCount int
+
+	// maxQ and minQ are monotonic deques of indexes into items, giving the
+	// window high and low in O(1).
+	maxQ []int
+	minQ []int
+
+	last    observation
+	hasLast bool
+}
+
+// NewRollingStats returns statistics over the trailing window, e.g.
+// 24*time.Hour for daily figures.
+func NewRollingStats(window time.Duration) *RollingStats {
+	return &RollingStats{window: window}
+}
+
+// Add records a price and traded volume observed at ts and evicts
+// observations that fell out of the window. Ticker updates without volume
+// are added with volume 0. Observations must arrive in chronological
+// order; one older than the previous observation is ignored.
+func (s *RollingStats) Add(ts time.Time, price, volume float64) {
+	if price <= 0 || math.IsNaN(price) {
+		return
+	}
+	if s.hasLast && ts.Before(s.last.at) {
+		return
+	}
+
+	obs := observation{at: ts, price: price, volume: volume}
+	if s.hasLast {
+		obs.ret = math.Log(price / s.last.price)
+		obs.hasRet = true
+	}
+	s.last = obs
+	s.hasLast = true
+
+	idx := len(s.items)
+	s.items = append(s.items, obs)
+
+	s.volume += volume
+	s.notional += volume * price
+	if obs.hasRet {
+		s.retSum += obs.ret
+		s.retSqSum += obs.ret * obs.ret
+		s.retCount++
+	}
+
+	for len(s.maxQ) > 0 && s.items[s.maxQ[len(s.maxQ)-1]].price <= price {
+		s.maxQ = s.maxQ[:len(s.maxQ)-1]
+	}
+	s.maxQ = append(s.maxQ, idx)
+
+	for len(s.minQ) > 0 && s.items[s.minQ[len(s.minQ)-1]].price >= price {
+		s.minQ = s.minQ[:len(s.minQ)-1]
+	}
+	s.minQ = append(s.minQ, idx)
+
+	s.evict(ts)
+}
+
+// AddTrade records a public trade as returned by GetRecentTrades.
+// Trades with unparsable price or volume are ignored. GetRecentTrades
+// lists trades newest first, so add them in reverse.
+func (s *RollingStats) AddTrade(trade t.Trade) {
+	price, err := strconv.ParseFloat(trade.Price, 64)
+	if err != nil {
+		return
+	}
+	volume, err := strconv.ParseFloat(trade.Volume, 64)
+	if err != nil {
+		return
+	}
+	s.Add(time.UnixMilli(trade.Time), price, volume)
+}
+
+// AddTicker records the latest price of a ticker observed at ts.
+func (s *RollingStats) AddTicker(ts time.Time, ticker t.Ticker) {
+	price, err := strconv.ParseFloat(ticker.Latest, 64)
+	if err != nil {
+		return
+	}
+	s.Add(ts, price, 0)
+}
+
+// Advance evicts observations older than the window relative to now, so
+// queries reflect the current time even when no new data arrives.
+func (s *RollingStats) Advance(now time.Time) {
+	s.evict(now)
+}
+
+// evict drops observations that are older than now - window.
+func (s *RollingStats) evict(now time.Time) {
+	cutoff := now.Add(-s.window)
+
+	for s.head < len(s.items) && s.items[s.head].at.Before(cutoff) {
+		old := s.items[s.head]
+		s.volume -= old.volume
+		s.notional -= old.volume * old.price
+
+		// The return stored on the next observation refers to this one,
+		// which leaves the window; drop it with it.
+		if s.head+1 < len(s.items) && s.items[s.head+1].hasRet {
+			next := &s.items[s.head+1]
+			s.retSum -= next.ret
+			s.retSqSum -= next.ret * next.ret
+			s.retCount--
+			next.hasRet = false
+		}
+
+		if len(s.maxQ) > 0 && s.maxQ[0] == s.head {
+			s.maxQ = s.maxQ[1:]
+		}
+		if len(s.minQ) > 0 && s.minQ[0] == s.head {
+			s.minQ = s.minQ[1:]
+		}
+		s.head++
+	}
+
+	s.compact()
+}
+
+// compact reclaims the evicted prefix of items once it dominates the slice.
+func (s *RollingStats) compact() {
+	if s.head < 1024 || s.head < len(s.items)/2 {
+		return
+	}
+
+	shift := s.head
+	s.items = append(s.items[:0], s.items[shift:]...)
+	for i := range s.maxQ {
+		s.maxQ[i] -= shift
+	}
+	for i := range s.minQ {
+		s.minQ[i] -= shift
+	}
+	s.head = 0
+}
+
+// Count returns the number of observations in the window.
+func (s *RollingStats) Count() int {
+	return len(s.items) - s.head
+}
+
+// Volume returns the base-currency volume traded in the window.
+func (s *RollingStats) Volume() float64 {
+	if s.Count() == 0 {
+		return 0
+	}
+	return s.volume
+}
+
+// QuoteVolume returns the quote-currency (price × volume) traded in the window.
+func (s *RollingStats) QuoteVolume() float64 {
+	if s.Count() == 0 {
+		return 0
+	}
+	return s.notional
+}
+
+// VWAP returns the volume-weighted average price of the window, or 0
+// when no volume was traded.
+func (s *RollingStats) VWAP() float64 {
+	if s.Count() == 0 || s.volume <= 0 {
+		return 0
+	}
+	return s.notional / s.volume
+}
+
+// High returns the highest price in the window, or 0 when it is empty.
+func (s *RollingStats) High() float64 {
+	if len(s.maxQ) == 0 {
+		return 0
+	}
+	return s.items[s.maxQ[0]].price
+}
+
+// Low returns the lowest price in the window, or 0 when it is empty.
+func (s *RollingStats) Low() float64 {
+	if len(s.minQ) == 0 {
+		return 0
+	}
+	return s.items[s.minQ[0]].price
+}
+
+// Open returns the oldest price in the window, or 0 when it is empty.
+func (s *RollingStats) Open() float64 {
+	if s.Count() == 0 {
+		return 0
+	}
+	return s.items[s.head].price
+}
+
+// Last returns the newest price in the window, or 0 when it is empty.
+func (s *RollingStats) Last() float64 {
+	if s.Count() == 0 {
+		return 0
+	}
+	return s.items[len(s.items)-1].price
+}
+
+// Change returns the relative price change over the window (0.05 = +5%).
+func (s *RollingStats) Change() float64 {
+	open := s.Open()
+	if open == 0 {
+		return 0
+	}
+	return s.Last()/open - 1
+}
+
+// Volatility returns the standard deviation of log returns between
+// consecutive observations in the window. It is not annualized.
+func (s *RollingStats) Volatility() float64 {
+	if s.retCount < 2 {
+		return 0
+	}
+
+	n := float64(s.retCount)
+	mean := s.retSum / n
+	variance := (s.retSqSum - n*mean*mean) / (n - 1)
+	if variance <= 0 {
+		return 0
+	}
+	return math.Sqrt(variance)
+}
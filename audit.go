@@ -0,0 +1,129 @@
+package nobitex
+
+import (
+	"encoding/json"
+	"io"
+	"os"
+	"sync"
+	"time"
+)
+
+// Audited actions.
+const (
+	AuditCreateOrder     = "create_order"
+	AuditCancelOrder     = "cancel_order"
+	AuditCancelOrderBulk = "cancel_order_bulk"
+)
+
+// AuditEntry records one trading action.
+type AuditEntry struct {
+	// Time is when the call returned.
+	Time time.Time `json:"time"`
+
+	// Action is one of the Audit* constants.
+	Action string `json:"action"`
+
+	// Account is the username of the client, if it logged in with one.
+	Account string `json:"account,omitempty"`
+
+	// Params is the request as sent. Fields tagged `json:"-"`, such as
+	// OTP codes, are never serialized.
+	Params any `json:"params"`
+
+	// Result is the decoded response of a successful call.
+	Result any `json:"result,omitempty"`
+
+	// Error is the error of a failed call.
+	Error string `json:"error,omitempty"`
+
+	// Duration is how long the call took, retries included.
+	Duration time.Duration `json:"duration"`
+}
+
+// Auditor receives an AuditEntry for every order creation and
+// cancellation, successful or not. Audit is called on the request
+// goroutine and must be safe for concurrent use.
+type Auditor interface {
+	Audit(entry AuditEntry)
+}
+
+// JSONLAuditor writes audit entries as JSON lines. Writes are serialized;
+// the first write error is kept and reported by Err, and later entries
+// are still attempted.
+type JSONLAuditor struct {
+	mu  sync.Mutex
+	w   io.Writer
+	err error
+}
+
+// NewJSONLAuditor returns an Auditor writing to w.
+func NewJSONLAuditor(w io.Writer) *JSONLAuditor {
+	return &JSONLAuditor{w: w}
+}
+
+// OpenAuditLog opens path for appending, creating it with owner-only
+// permissions, and returns an auditor writing to it together with the
+// file, which the caller closes.
+//
+// Example:
+//
+//	auditor, file, err := nobitex.OpenAuditLog("/var/log/bot/audit.jsonl")
+//	if err != nil {
+//	    return err
+//	}
+//	defer file.Close()
+//	client, err := nobitex.NewClient(nobitex.ClientOptions{Auditor: auditor, ...})
+func OpenAuditLog(path string) (*JSONLAuditor, *os.File, error) {
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o600)
+	if err != nil {
+		return nil, nil, err
+	}
+	return NewJSONLAuditor(file), file, nil
+}
+
+// Audit writes entry as one line.
+func (a *JSONLAuditor) Audit(entry AuditEntry) {
+	line, err := json.Marshal(entry)
+	if err == nil {
+		line = append(line, '\n')
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if err == nil {
+		_, err = a.w.Write(line)
+	}
+	if err != nil && a.err == nil {
+		a.err = err
+	}
+}
+
+// Err returns the first error encountered while writing.
+func (a *JSONLAuditor) Err() error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.err
+}
+
+// audit reports a trading action to the configured Auditor.
+func (c *Client) audit(action string, started time.Time, params any, result any, err error) {
+	if c.auditor == nil {
+		return
+	}
+
+	entry := AuditEntry{
+		Time:     time.Now(),
+		Action:   action,
+		Account:  c.Username,
+		Params:   params,
+		Duration: time.Since(started),
+	}
+	if err != nil {
+		entry.Error = err.Error()
+	} else {
+		entry.Result = result
+	}
+	safeHook("auditor", c.onPanic, func() {
+		c.auditor.Audit(entry)
+	})
+}
@@ -0,0 +1,252 @@
+package nobitex
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/darhelm/go-nobitex/store"
+	t "github.com/darhelm/go-nobitex/types"
+)
+
+// DefaultBackfillInterval is the minimum delay between two page requests
+// issued by BackfillTrades, keeping multi-hour backfills well below the
+// trade-list rate limit.
+const DefaultBackfillInterval = time.Second
+
+// TradeSink receives the pages of trades downloaded by BackfillTrades.
+// Implementations typically append to a database or file.
+type TradeSink interface {
+	// WriteTrades stores one page of trades. Returning an error aborts the
+	// backfill; the cursor of the failed page is not advanced.
+	WriteTrades(ctx context.Context, trades []t.UserTradeResponse) error
+}
+
+// TradeSinkFunc adapts a plain function to the TradeSink interface.
+type TradeSinkFunc func(ctx context.Context, trades []t.UserTradeResponse) error
+
+// WriteTrades calls f(ctx, trades).
+func (f TradeSinkFunc) WriteTrades(ctx context.Context, trades []t.UserTradeResponse) error {
+	return f(ctx, trades)
+}
+
+// BackfillProgress is reported after every page has been written to the sink.
+type BackfillProgress struct {
+	// Pages is the number of pages fetched so far.
+	Pages int
+
+	// Trades is the number of trades written to the sink so far.
+	Trades int
+
+	// LastTradeTime is the timestamp of the newest trade written so far.
+	LastTradeTime time.Time
+
+	// Cursor is the encoded resume position (see Cursor) after this page.
+	// Persisting it and passing it back through BackfillOptions.Cursor
+	// continues the backfill exactly after the last written trade.
+	Cursor string
+}
+
+// BackfillOptions configures BackfillTrades.
+type BackfillOptions struct {
+	// Params holds the currency filters of the listing. FromId is managed
+	// by the backfill and ignored.
+	Params t.GetUserTradesParams
+
+	// Cursor resumes a previous backfill from a saved BackfillProgress.Cursor.
+	Cursor string
+
+	// Store, if set, persists the cursor under StoreKey after every page
+	// and resumes from it when Cursor is empty.
+	Store store.Store
+
+	// StoreKey names the cursor in Store. Defaults to "backfill-trades".
+	StoreKey string
+
+	// Interval is the minimum delay between page requests.
+	// Defaults to DefaultBackfillInterval.
+	Interval time.Duration
+
+	// MaxRetries bounds how many times a rate-limited (429) or failed page
+	// request is retried with exponential backoff. Defaults to 5.
+	MaxRetries int
+
+	// OnProgress, if set, is called after every page written to the sink.
+	OnProgress func(BackfillProgress)
+}
+
+// BackfillTrades downloads the user's complete trade history from since
+// onwards and writes it page by page to sink.
+//
+// Parameters:
+//   - ctx: Cancels the backfill between pages and during waits.
+//   - sink: Destination of the downloaded trades.
+//   - since: Trades executed before this time are skipped. Zero means the
+//     whole history.
+//   - opts: Filters, resume cursor, pacing and progress reporting.
+//
+// Returns:
+//   - The final progress (including the cursor to resume from later).
+//   - An error if ctx is done, the sink fails, or a page keeps failing after
+//     MaxRetries attempts. The returned progress is valid in every case.
+//
+// Behavior:
+//   - Walks /market/trades/list forward by trade Id, so the cursor holds the
+//     next FromId to request. A cursor issued for another endpoint is
+//     rejected.
+//   - Waits at least Interval between requests and backs off exponentially
+//     (starting at 5s, capped at 1 minute) on 429 and transport errors.
+//   - Pages whose trades all fall before since are fetched but not written.
+//
+// Example:
+//
+//	progress, err := client.BackfillTrades(ctx, sink, time.Time{}, nobitex.BackfillOptions{
+//	    Cursor: loadCursor(),
+//	    OnProgress: func(p nobitex.BackfillProgress) { saveCursor(p.Cursor) },
+//	})
+func (c *Client) BackfillTrades(ctx context.Context, sink TradeSink, since time.Time, opts BackfillOptions) (BackfillProgress, error) {
+	interval := opts.Interval
+	if interval <= 0 {
+		interval = DefaultBackfillInterval
+	}
+
+	maxRetries := opts.MaxRetries
+	if maxRetries <= 0 {
+		maxRetries = 5
+	}
+
+	storeKey := opts.StoreKey
+	if storeKey == "" {
+		storeKey = "backfill-trades"
+	}
+	if opts.Cursor == "" && opts.Store != nil {
+		saved, err := opts.Store.Get(ctx, store.BucketCursors, storeKey)
+		if err != nil && !errors.Is(err, store.ErrNotFound) {
+			return BackfillProgress{}, err
+		}
+		opts.Cursor = string(saved)
+	}
+
+	cursor, err := decodeCursorFor(opts.Cursor, CursorTrades)
+	if err != nil {
+		return BackfillProgress{Cursor: opts.Cursor}, err
+	}
+
+	progress := BackfillProgress{Cursor: opts.Cursor}
+	params := opts.Params
+	params.FromId = ""
+
+	for {
+		if cursor.FromId > 0 {
+			params.FromId = strconv.FormatInt(cursor.FromId, 10)
+		}
+
+		page, err := c.backfillPage(ctx, params, maxRetries)
+		if err != nil {
+			return progress, err
+		}
+		progress.Pages++
+
+		batch := make([]t.UserTradeResponse, 0, len(page.Trades))
+		maxId := -1
+		for _, trade := range page.Trades {
+			if trade.Id > maxId {
+				maxId = trade.Id
+			}
+			if !since.IsZero() && trade.Timestamp.Before(since) {
+				continue
+			}
+			batch = append(batch, trade)
+		}
+
+		if len(batch) > 0 {
+			if err := sink.WriteTrades(ctx, batch); err != nil {
+				return progress, &GoNobitexError{
+					Message: "trade sink failed",
+					Err:     err,
+				}
+			}
+			progress.Trades += len(batch)
+			for _, trade := range batch {
+				if trade.Timestamp.After(progress.LastTradeTime) {
+					progress.LastTradeTime = trade.Timestamp
+				}
+			}
+		}
+
+		previous := cursor.FromId
+		if int64(maxId) >= previous {
+			cursor.FromId = int64(maxId) + 1
+			if progress.LastTradeTime.After(cursor.Time) {
+				cursor.Time = progress.LastTradeTime
+			}
+			progress.Cursor = cursor.Encode()
+
+			if opts.Store != nil {
+				if err := opts.Store.Put(ctx, store.BucketCursors, storeKey, []byte(progress.Cursor)); err != nil {
+					return progress, err
+				}
+			}
+		}
+
+		if opts.OnProgress != nil {
+			opts.OnProgress(progress)
+		}
+
+		// Stop when the API has nothing more or the cursor did not move
+		if !page.HasNext || int64(maxId) < previous {
+			return progress, nil
+		}
+
+		if err := sleepContext(ctx, interval); err != nil {
+			return progress, err
+		}
+	}
+}
+
+// backfillPage fetches one page, retrying rate-limit and transport failures.
+func (c *Client) backfillPage(ctx context.Context, params t.GetUserTradesParams, maxRetries int) (*t.UserTrades, error) {
+	backoff := 5 * time.Second
+
+	for attempt := 0; ; attempt++ {
+		page, err := c.userTradesPage(params, WithContext(ctx))
+		if err == nil {
+			return page, nil
+		}
+
+		if ctx.Err() != nil || attempt >= maxRetries || !isRetryableBackfillError(err) {
+			return nil, err
+		}
+
+		if err := sleepContext(ctx, backoff); err != nil {
+			return nil, err
+		}
+		backoff = min(backoff*2, time.Minute)
+	}
+}
+
+// isRetryableBackfillError reports whether a page request is worth retrying.
+func isRetryableBackfillError(err error) bool {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode == http.StatusTooManyRequests || apiErr.StatusCode >= 500
+	}
+
+	var reqErr *RequestError
+	return errors.As(err, &reqErr) && reqErr.Operation == "sending request"
+}
+
+// sleepContext waits for d or until ctx is done, whichever comes first.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
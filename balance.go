@@ -0,0 +1,77 @@
+package nobitex
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+
+	t "github.com/darhelm/go-nobitex/types"
+)
+
+// GetBalance returns the parsed wallet of one currency.
+//
+// Parameters:
+//   - ctx: Context of the underlying GetWallets request.
+//   - currency: Asset symbol such as "btc" or "rls"; case-insensitive.
+//
+// Returns:
+//   - *t.Balance with Total, Blocked and Available as exact decimals.
+//   - A GoNobitexError if the account has no wallet for currency or the
+//     response holds malformed amounts.
+//
+// Example:
+//
+//	bal, err := client.GetBalance(ctx, "btc")
+//	if err != nil {
+//	    return err
+//	}
+//	fmt.Println(bal.Available.FloatString(8))
+func (c *Client) GetBalance(ctx context.Context, currency string, opts ...RequestOption) (*t.Balance, error) {
+	currency = strings.ToLower(currency)
+
+	opts = append([]RequestOption{WithContext(ctx)}, opts...)
+	wallets, err := c.GetWallets(t.GetWalletParams{Currencies: []string{currency}}, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	wallet, ok := wallets.Wallets[currency]
+	if !ok {
+		return nil, &GoNobitexError{Message: fmt.Sprintf("no %s wallet", currency)}
+	}
+	return parseBalance(currency, wallet)
+}
+
+// parseBalance converts a wallet entry into a Balance.
+func parseBalance(currency string, wallet t.Wallet) (*t.Balance, error) {
+	total, err := parseDecimal(currency+" balance", orZero(wallet.Balance))
+	if err != nil {
+		return nil, err
+	}
+	blocked, err := parseDecimal(currency+" blocked balance", orZero(wallet.Blocked))
+	if err != nil {
+		return nil, err
+	}
+
+	available := new(big.Rat).Sub(total, blocked)
+	if available.Sign() < 0 {
+		available.SetInt64(0)
+	}
+
+	return &t.Balance{
+		Currency:  currency,
+		WalletId:  wallet.Id,
+		Total:     total,
+		Blocked:   blocked,
+		Available: available,
+	}, nil
+}
+
+// orZero maps an empty amount to "0".
+func orZero(value string) string {
+	if value == "" {
+		return "0"
+	}
+	return value
+}
@@ -0,0 +1,99 @@
+package nobitex
+
+import (
+	"errors"
+	"io"
+)
+
+// DefaultMaxResponseBodySize bounds how many bytes of a response body are
+// read, unless overridden through ClientOptions.MaxResponseBodySize.
+const DefaultMaxResponseBodySize = 32 << 20
+
+// maxErrorBodySize bounds how much of a non-2xx body is buffered for error
+// parsing. Nobitex error payloads are tiny; anything bigger is usually an
+// HTML page from a proxy, and its head is enough to build the APIError.
+const maxErrorBodySize = 64 << 10
+
+// ErrResponseTooLarge is returned when a response body exceeds the
+// configured maximum size.
+var ErrResponseTooLarge = errors.New("response body exceeds maximum size")
+
+// ErrEmptyResponse is returned when a successful response that should
+// carry a result has an empty body, as happens with 204 responses and
+// with truncated 200s during incidents.
+var ErrEmptyResponse = errors.New("empty response body")
+
+// limitedBody streams at most limit bytes from r and fails with
+// ErrResponseTooLarge once more are available, so decoders never buffer an
+// unbounded payload.
+type limitedBody struct {
+	r         io.Reader
+	remaining int64
+}
+
+// newLimitedBody wraps r. A negative limit disables the check.
+func newLimitedBody(r io.Reader, limit int64) io.Reader {
+	if limit < 0 {
+		return r
+	}
+	return &limitedBody{r: r, remaining: limit}
+}
+
+func (l *limitedBody) Read(p []byte) (int, error) {
+	if l.remaining <= 0 {
+		// Probe for one more byte to tell "exactly at the limit" from "over"
+		var probe [1]byte
+		n, err := l.r.Read(probe[:])
+		if n > 0 {
+			return 0, ErrResponseTooLarge
+		}
+		return 0, err
+	}
+
+	if int64(len(p)) > l.remaining {
+		p = p[:l.remaining]
+	}
+	n, err := l.r.Read(p)
+	l.remaining -= int64(n)
+	return n, err
+}
+
+// readErrorBody buffers at most limit bytes of a non-2xx body. The rest is
+// left unread and the connection is closed instead of drained.
+func readErrorBody(r io.Reader, limit int64) ([]byte, error) {
+	if limit < 0 || limit > maxErrorBodySize {
+		limit = maxErrorBodySize
+	}
+	return io.ReadAll(io.LimitReader(r, limit))
+}
+
+// responseBodyLimit resolves the configured limit: zero means the default,
+// negative means unlimited.
+func responseBodyLimit(configured int64) int64 {
+	if configured == 0 {
+		return DefaultMaxResponseBodySize
+	}
+	return configured
+}
+
+// decodeError wraps a failure of a response handler. Empty bodies are
+// reported as "reading response" so they are retried like other
+// transport failures.
+func decodeError(err error) error {
+	if errors.Is(err, ErrEmptyResponse) {
+		return &RequestError{
+			GoNobitexError: GoNobitexError{
+				Message: "response has no body",
+				Err:     err,
+			},
+			Operation: "reading response",
+		}
+	}
+	return &RequestError{
+		GoNobitexError: GoNobitexError{
+			Message: "failed to decode response",
+			Err:     err,
+		},
+		Operation: "parsing response",
+	}
+}
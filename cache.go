@@ -0,0 +1,54 @@
+package nobitex
+
+import (
+	"sync"
+	"time"
+)
+
+// ttlCache is a small concurrency-safe key/value cache whose entries expire
+// after a fixed lifetime. A zero ttl disables the cache entirely.
+type ttlCache struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	entries map[string]ttlEntry
+}
+
+type ttlEntry struct {
+	value   interface{}
+	expires time.Time
+}
+
+// get returns the cached value for key if present and not expired.
+func (tc *ttlCache) get(key string) (interface{}, bool) {
+	if tc.ttl <= 0 {
+		return nil, false
+	}
+
+	tc.mu.Lock()
+	defer tc.mu.Unlock()
+
+	entry, ok := tc.entries[key]
+	if !ok {
+		return nil, false
+	}
+	if time.Now().After(entry.expires) {
+		delete(tc.entries, key)
+		return nil, false
+	}
+	return entry.value, true
+}
+
+// set stores value under key for the configured ttl.
+func (tc *ttlCache) set(key string, value interface{}) {
+	if tc.ttl <= 0 {
+		return
+	}
+
+	tc.mu.Lock()
+	defer tc.mu.Unlock()
+
+	if tc.entries == nil {
+		tc.entries = make(map[string]ttlEntry)
+	}
+	tc.entries[key] = ttlEntry{value: value, expires: time.Now().Add(tc.ttl)}
+}
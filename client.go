@@ -2,21 +2,47 @@ package nobitex
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"strings"
+	"sync"
 	"time"
 
+	"github.com/darhelm/go-nobitex/store"
 	t "github.com/darhelm/go-nobitex/types"
 	u "github.com/darhelm/go-nobitex/utils"
+	"golang.org/x/sync/singleflight"
 )
 
 // Constants defining the API base URL and version.
 const (
 	// BaseUrl is the root URL for the Nobitex Market API.
 	BaseUrl = "https://apiv2.nobitex.ir"
+
+	// TestnetBaseUrl is the root URL of the Nobitex test environment. It
+	// trades test funds only and needs a separate account and API key
+	// from testnet.nobitex.ir; production credentials are rejected there.
+	TestnetBaseUrl = "https://testnetapi.nobitex.ir"
+
+	// Version is the release of this SDK.
+	Version = "0.2.0"
+
+	// DefaultUserAgent identifies the SDK when ClientOptions.UserAgent is
+	// empty. It is sent as "TraderBot/" + UserAgent, as Nobitex requires
+	// for bot traffic.
+	DefaultUserAgent = "go-nobitex v" + Version
+
+	// DefaultTimeout is the request timeout used when ClientOptions sets
+	// neither HttpClient nor Timeout.
+	DefaultTimeout = 30 * time.Second
+
+	// maxDrainBytes bounds how much unread response body is discarded to
+	// keep a connection reusable before giving up and closing it.
+	maxDrainBytes = 64 << 10
 )
 
 // ClientOptions represents the configuration options for creating a new API client.
@@ -27,19 +53,45 @@ type ClientOptions struct {
 	// If nil, the default HTTP client is used.
 	HttpClient *http.Client
 
-	// Timeout specifies the request timeout duration for the HTTP client.
+	// Timeout bounds how long the server may take to send response
+	// headers, and how long each attempt of a call whose response is
+	// decoded in full may take. Streamed responses (RequestStream,
+	// ApiRequestStream) are only bounded by the call's context once the
+	// headers arrive. Defaults to DefaultTimeout; ignored when HttpClient
+	// is set.
 	Timeout time.Duration
 
 	// BaseUrl is the base URL of the API. Defaults to the constant BaseUrl
 	// if not provided.
 	BaseUrl string
 
+	// Testnet points the client at TestnetBaseUrl so integrations can be
+	// developed without real funds. It cannot be combined with BaseUrl or
+	// BaseUrls.
+	Testnet bool
+
+	// BaseUrls lists mirror base URLs tried in order when BaseUrl is
+	// unreachable or returns gateway errors. BaseUrl always has priority.
+	BaseUrls []string
+
+	// FailoverCooldown is how long a failing base URL is skipped before it
+	// is tried again. Defaults to DefaultFailoverCooldown.
+	FailoverCooldown time.Duration
+
 	Username  string
 	Password  string
 	OtpSecret string
 	OtpCode   string
 
-	Remember  string
+	// Remember selects short-lived (t.RememberNo, the default) or
+	// long-lived (t.RememberYes) API keys when logging in. Invalid values
+	// make NewClient fail; use t.ParseRemember to convert loose strings
+	// such as "true" or "1".
+	Remember t.Remember
+
+	// UserAgent names the application in the "TraderBot/<UserAgent>"
+	// header Nobitex requires on authenticated calls. Defaults to
+	// DefaultUserAgent.
 	UserAgent string
 
 	// ApiKey is the token used for authenticated API requests.
@@ -50,6 +102,119 @@ type ClientOptions struct {
 
 	// AutoRefresh enables automatic refreshing of the access token when it expires.
 	AutoRefresh bool
+
+	// ConfigTTL controls how long Client.Config serves the cached /options
+	// payload before fetching it again. Defaults to DefaultConfigTTL.
+	ConfigTTL time.Duration
+
+	// MarketDataCacheTTL enables a read-through cache for GetTickers and
+	// GetOrderBook responses. Identical calls within this window share one
+	// response. Zero (the default) disables caching; sub-second values such
+	// as 250*time.Millisecond are typical.
+	MarketDataCacheTTL time.Duration
+
+	// DeduplicateRequests collapses identical public GET requests issued
+	// concurrently by several goroutines into a single HTTP call whose
+	// response is shared by all callers.
+	DeduplicateRequests bool
+
+	// Metrics receives connection-level and request-level metrics.
+	Metrics MetricsHook
+
+	// Debug dumps every request and response (httputil.DumpRequestOut /
+	// DumpResponse) to DebugWriter. Authorization, X-TOTP, passwords and
+	// API keys are masked.
+	Debug bool
+
+	// DebugWriter receives debug dumps. Defaults to os.Stderr.
+	DebugWriter io.Writer
+
+	// Retry configures automatic retries with exponential backoff and
+	// jitter. The zero value disables retries.
+	Retry RetryPolicy
+
+	// MaxConcurrentRequests caps how many requests the client has in
+	// flight at once; further calls wait for a free slot or their context.
+	// Zero means unlimited.
+	MaxConcurrentRequests int
+
+	// RateLimits assigns a separate request budget to each endpoint
+	// family, so heavy market-data polling cannot starve order placement.
+	// Calls wait for budget (or their context) before being sent.
+	// Families without an entry are not limited.
+	//
+	// Example:
+	//
+	//	RateLimits: map[nobitex.EndpointFamily]nobitex.RateLimit{
+	//	    nobitex.FamilyOrders:     {Requests: 100, Per: 10 * time.Minute},
+	//	    nobitex.FamilyMarketData: {Requests: 60, Per: time.Minute},
+	//	}
+	RateLimits map[EndpointFamily]RateLimit
+
+	// MaxResponseBodySize bounds how many bytes of a successful response
+	// are streamed to the decoder; larger bodies fail with
+	// ErrResponseTooLarge instead of growing memory without limit. Error
+	// bodies are always capped at a few kilobytes. Defaults to
+	// DefaultMaxResponseBodySize; a negative value disables the limit.
+	MaxResponseBodySize int64
+
+	// OnPanic receives panics recovered from callbacks that have no caller
+	// to return an error to, such as metrics hooks and event subscribers.
+	// Panics while decoding a response are returned from the call as
+	// *PanicError instead.
+	OnPanic func(err error)
+
+	// Store persists the orders tracked for WatchOrders, so a restarted
+	// process can pick them up again with RestoreOrders. Optional.
+	Store store.Store
+
+	// OnStoreError receives errors writing tracked orders to Store. The
+	// orders stay tracked in memory. Optional; errors are dropped when
+	// unset.
+	OnStoreError func(err error)
+
+	// MaintenanceProbeInterval is how often a client degraded by a
+	// maintenance response probes for recovery.
+	// Defaults to DefaultMaintenanceProbeInterval.
+	MaintenanceProbeInterval time.Duration
+
+	// StatsReporter, if set, receives every counter increment (requests,
+	// errors, retries, auth refreshes, failovers, maintenance entries).
+	// See also Client.Counters and Client.PublishExpvar.
+	StatsReporter StatsReporter
+
+	// Auditor, if set, records every order creation, cancellation and
+	// withdrawal request with its parameters, result and error, e.g. to an
+	// append-only JSONL file (see OpenAuditLog).
+	Auditor Auditor
+
+	// StrictStatus makes ApiRequest fail with an *APIError whenever a
+	// decoded response wrapper reports a status other than "ok", even on
+	// HTTP 200. See CheckStatus.
+	StrictStatus bool
+
+	// SlowRequestThreshold, if positive, reports every exchange that takes
+	// longer than it to MetricsHook.OnSlowRequest, or as a warning on
+	// DebugWriter when that hook is nil. Zero disables the check.
+	SlowRequestThreshold time.Duration
+
+	// HealthProbeInterval, if positive, starts a background goroutine that
+	// pings the public status endpoint at this interval and maintains
+	// Client.Healthy and Client.LastError. It stops when the client is
+	// closed.
+	HealthProbeInterval time.Duration
+
+	// ReadOnly makes the client reject every mutating call (orders,
+	// cancellations, withdrawals, conversions, loans, staking, ...) with
+	// an error wrapping ErrReadOnly before it is sent, so monitoring and
+	// analytics deployments can reuse production credentials safely.
+	// Login and read-only POST endpoints keep working.
+	ReadOnly bool
+
+	// RiskLimits configures pre-trade checks (order value, open orders
+	// per market, daily volume, banned markets) that CreateOrder enforces
+	// before sending an order; violations are returned as *RiskError.
+	RiskLimits RiskLimits
 }
 
 // Client represents the API client for interacting with the Nobitex Market API.
@@ -68,7 +233,7 @@ type Client struct {
 	OtpSecret string
 	OtpCode   string
 
-	Remember  string
+	Remember  t.Remember
 	UserAgent string
 
 	AuthTime time.Time
@@ -81,6 +246,63 @@ type Client struct {
 
 	// AutoRefresh enables automatic refreshing of the access token when it expires.
 	AutoRefresh bool
+
+	// caches holds state shared with clones (see Clone); use c.shared().
+	caches     *sharedCaches
+	cachesOnce sync.Once
+
+	deduplicate bool
+	inflight    singleflight.Group
+
+	metrics      MetricsHook
+	connCounters connCounters
+
+	debug       bool
+	debugWriter io.Writer
+	debugLog    debugLogger
+
+	failover failoverPool
+
+	clock clockTracker
+
+	events EventBus
+
+	retry RetryPolicy
+
+	maintenance maintenanceState
+
+	lifecycle lifecycle
+
+	limiter concurrencyLimiter
+
+	rateLimits rateLimiters
+
+	maxBodySize int64
+
+	onPanic func(error)
+
+	strictStatus bool
+
+	latency latencyTracker
+
+	counters counters
+
+	reporter StatsReporter
+
+	auditor Auditor
+
+	slowThreshold time.Duration
+
+	// timeout bounds each attempt of a fully decoded call; zero when a
+	// custom HttpClient is used.
+	timeout time.Duration
+
+	health healthState
+
+	readOnly bool
+
+	risk      RiskLimits
+	riskState *riskState
 }
 
 // NewClient initializes a new Nobitex API client using the provided configuration
@@ -92,12 +314,32 @@ type Client struct {
 //   - HttpClient: optional custom HTTP client.
 //   - Timeout: request timeout used when no custom client is provided.
 //   - BaseUrl: optional override for the Nobitex base URL.
+//   - BaseUrls / FailoverCooldown: mirror base URLs used for failover.
+//   - Testnet: use TestnetBaseUrl and testnet credentials.
 //   - Username / Password: credentials for API login.
 //   - OtpSecret / OtpCode: TOTP configuration for X-TOTP header.
 //   - ApiKey: an already-issued Nobitex API key (optional).
 //   - Remember: long-lived ("yes") vs short-lived ("no") login sessions.
 //   - AutoAuth: whether to auto-authenticate if ApiKey is missing.
 //   - AutoRefresh: whether to re-authenticate when Remember expires.
+//   - ConfigTTL: lifetime of the cached exchange configuration.
+//   - MarketDataCacheTTL: lifetime of cached tickers/order books (0 = off).
+//   - DeduplicateRequests: share in-flight public GET requests.
+//   - Metrics: optional callbacks for connection and request metrics.
+//   - Debug / DebugWriter: masked request/response dumps.
+//   - Retry: automatic retries with backoff and jitter.
+//   - MaxConcurrentRequests: cap on requests in flight at once.
+//   - RateLimits: per-endpoint-family request budgets.
+//   - MaxResponseBodySize: cap on decoded response bodies.
+//   - OnPanic: receives panics recovered from hooks and subscribers.
+//   - Store / OnStoreError: persistence for tracked orders.
+//   - StrictStatus: fail on responses whose status is not "ok".
+//   - StatsReporter: receives counter increments.
+//   - Auditor: records trading actions.
+//   - SlowRequestThreshold: latency above which requests are reported.
+//   - HealthProbeInterval: background availability probe (0 = off).
+//   - ReadOnly: reject mutating calls with ErrReadOnly.
+//   - RiskLimits: pre-trade checks enforced by CreateOrder.
 //
 // Returns:
 //   - A pointer to an initialized Client.
@@ -106,12 +348,20 @@ type Client struct {
 // Behavior:
 //   - If opts.BaseUrl is provided, it overrides the default base URL
 //     ("https://apiv2.nobitex.ir/").
-//   - If opts.HttpClient is nil, a new http.Client with opts.Timeout is created.
+//   - If opts.HttpClient is nil, a new http.Client is created whose
+//     transport waits at most opts.Timeout for response headers; the
+//     timeout also bounds each attempt of a fully decoded call through its
+//     context. The client sets no overall Timeout, which would cut off
+//     long streamed responses. Its transport attempts HTTP/2 and keeps idle connections alive so
+//     consecutive calls skip the TCP/TLS handshake.
 //   - If opts.ApiKey is empty and username/password+TOTP are provided,
 //     NewClient performs an immediate login by calling Authenticate().
 //   - If opts.OtpSecret is provided, NewClient automatically generates a TOTP
 //     one-time password using utils.GenerateOtpCode.
 //   - Sets AuthTime to the time of successful authentication.
+//   - UserAgent defaults to DefaultUserAgent.
+//   - Options are checked with ClientOptions.Validate first; every problem
+//     found is reported at once in an *OptionsError.
 //   - If AutoRefresh is enabled, immediately checks whether the API key must be
 //     refreshed based on Remember ("yes" = ~30 days, "no" = ~4 hours).
 //
@@ -140,15 +390,49 @@ type Client struct {
 //	    log.Fatalf("failed to initialize client: %v", err)
 //	}
 func NewClient(opts ClientOptions) (*Client, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+
 	client := &Client{
 		AutoRefresh: opts.AutoRefresh,
 		BaseUrl:     BaseUrl,
 		Remember:    opts.Remember,
+		UserAgent:   DefaultUserAgent,
 	}
 
+	client.failover.mirrors = opts.BaseUrls
+	client.failover.cooldown = opts.FailoverCooldown
+	client.caches = &sharedCaches{}
+	client.caches.config.ttl = opts.ConfigTTL
+	client.caches.market.ttl = opts.MarketDataCacheTTL
+	client.deduplicate = opts.DeduplicateRequests
+	client.metrics = opts.Metrics
+	client.debug = opts.Debug
+	client.debugWriter = opts.DebugWriter
+	client.retry = opts.Retry
+	client.maintenance.interval = opts.MaintenanceProbeInterval
+	client.limiter = newConcurrencyLimiter(opts.MaxConcurrentRequests)
+	client.maxBodySize = opts.MaxResponseBodySize
+	client.onPanic = opts.OnPanic
+	client.events.onPanic = opts.OnPanic
+	client.events.store = opts.Store
+	client.events.onStoreError = opts.OnStoreError
+	client.rateLimits = newRateLimiters(opts.RateLimits)
+	client.strictStatus = opts.StrictStatus
+	client.reporter = opts.StatsReporter
+	client.auditor = opts.Auditor
+	client.slowThreshold = opts.SlowRequestThreshold
+	client.readOnly = opts.ReadOnly
+	client.risk = opts.RiskLimits
+	client.riskState = &riskState{}
+
 	if opts.BaseUrl != "" {
 		client.BaseUrl = opts.BaseUrl
 	}
+	if opts.Testnet {
+		client.BaseUrl = TestnetBaseUrl
+	}
 
 	if opts.UserAgent != "" {
 		client.UserAgent = opts.UserAgent
@@ -157,39 +441,26 @@ func NewClient(opts ClientOptions) (*Client, error) {
 	if opts.HttpClient != nil {
 		client.HttpClient = opts.HttpClient
 	} else {
-		client.HttpClient = &http.Client{
-			Timeout: opts.Timeout,
+		timeout := opts.Timeout
+		if timeout == 0 {
+			timeout = DefaultTimeout
 		}
+		client.timeout = timeout
+		client.HttpClient = &http.Client{Transport: newTransport(timeout)}
 	}
 
-	client.Username = opts.Username
-	client.Password = opts.Password
-
-	if opts.ApiKey == "" && opts.Username != "" && opts.Password != "" && (opts.OtpCode != "" || opts.OtpSecret != "") {
-		if opts.OtpSecret != "" {
-			client.OtpSecret = opts.OtpSecret
-			code, err := u.GenerateOtpCode(opts.OtpSecret)
-			if err != nil {
-				return nil, err
-			}
-			client.OtpCode = code
-		}
-
-		if opts.OtpCode != "" {
-			client.OtpCode = opts.OtpCode
-		}
-
-		if _, err := client.Authenticate(opts.Username, opts.Password); err != nil {
-			return nil, err
-		}
-	} else {
-		client.ApiKey = opts.ApiKey
+	if err := client.login(Credentials{
+		Username:  opts.Username,
+		Password:  opts.Password,
+		OtpSecret: opts.OtpSecret,
+		OtpCode:   opts.OtpCode,
+		ApiKey:    opts.ApiKey,
+	}); err != nil {
+		return nil, err
 	}
 
-	client.AuthTime = time.Now()
-
-	if err := client.handleAutoRefresh(); err != nil {
-		return nil, err
+	if opts.HealthProbeInterval > 0 {
+		go client.probeHealth(opts.HealthProbeInterval)
 	}
 
 	return client, nil
@@ -261,11 +532,17 @@ func assertAuth(client *Client) error {
 //	createApiURI("/orderbook/BTCUSDT", "v3")
 //	→ "https://apiv2.nobitex.ir/v3/orderbook/BTCUSDT"
 func (c *Client) createApiURI(endpoint string, version string) string {
+	return joinApiURI(c.BaseUrl, endpoint, version)
+}
+
+// joinApiURI builds the URL for endpoint on an explicit base URL, following
+// the same rules as createApiURI. It is used when failing over to mirrors.
+func joinApiURI(baseUrl string, endpoint string, version string) string {
 	if version == "" {
-		return fmt.Sprintf("%s%s", c.BaseUrl, endpoint)
+		return fmt.Sprintf("%s%s", baseUrl, endpoint)
 	}
 
-	return fmt.Sprintf("%s/%s%s", c.BaseUrl, version, endpoint)
+	return fmt.Sprintf("%s/%s%s", baseUrl, version, endpoint)
 }
 
 // handleAutoRefresh enforces Nobitex's Remember-based session lifetime rules.
@@ -282,10 +559,12 @@ func (c *Client) createApiURI(endpoint string, version string) string {
 //   - RememberYes → expires in ~30 days
 //   - If the elapsed time since AuthTime exceeds the expected TTL,
 //     handleAutoRefresh generates a new TOTP and re-executes Authenticate().
-//   - Updates c.OtpCode automatically when using OtpSecret.
+//   - Updates c.OtpCode automatically when using OtpSecret. The code is
+//     generated for ServerNow(), so a drifting local clock does not yield
+//     rejected TOTPs.
 //
 // Dependencies:
-//   - utils.GenerateOtpCode
+//   - utils.GenerateOtpCodeAt
 //   - Authenticate()
 //
 // Errors:
@@ -309,9 +588,9 @@ func (c *Client) handleAutoRefresh() error {
 	var ttl time.Duration
 
 	switch c.Remember {
-	case "no", "":
+	case t.RememberNo, "":
 		ttl = 4 * time.Hour
-	case "yes":
+	case t.RememberYes:
 		ttl = 30 * 24 * time.Hour
 	default:
 		return &GoNobitexError{
@@ -319,24 +598,40 @@ func (c *Client) handleAutoRefresh() error {
 		}
 	}
 
-	if time.Since(c.AuthTime) > ttl {
-		code, err := u.GenerateOtpCode(c.OtpSecret)
-		c.OtpCode = code
-		if err != nil {
-			return &GoNobitexError{
-				Message: fmt.Sprintf("failed to generate Otp code: %v", err),
-				Err:     err,
-			}
-		}
+	if age := time.Since(c.AuthTime); age > ttl {
+		started := time.Now()
+		err := c.refreshApiKey()
+		c.reportAuthRefresh(AuthRefreshInfo{
+			Reason:   AuthRefreshExpired,
+			KeyAge:   age,
+			Started:  started,
+			Duration: time.Since(started),
+			Err:      err,
+		})
+		return err
+	}
 
-		if _, err := c.Authenticate(c.Username, c.Password); err != nil {
-			return &GoNobitexError{
-				Message: fmt.Sprintf("failed to refresh Api Key with Remember = '%s'", c.Remember),
-				Err:     err,
-			}
+	return nil
+}
+
+// refreshApiKey generates a fresh TOTP code and logs in again.
+func (c *Client) refreshApiKey() error {
+	code, err := u.GenerateOtpCodeAt(c.OtpSecret, c.ServerNow())
+	c.OtpCode = code
+	if err != nil {
+		return &GoNobitexError{
+			Message: fmt.Sprintf("failed to generate Otp code: %v", err),
+			Err:     err,
 		}
 	}
 
+	if _, err := c.Authenticate(c.Username, c.Password); err != nil {
+		return &GoNobitexError{
+			Message: fmt.Sprintf("failed to refresh Api Key with Remember = '%s'", c.Remember),
+			Err:     err,
+		}
+	}
+	c.count(CounterAuthRefreshes, &c.counters.authRefreshes)
 	return nil
 }
 
@@ -348,12 +643,14 @@ func (c *Client) handleAutoRefresh() error {
 //   - structured API error handling
 //
 // Parameters:
-//   - method: "GET" or "POST".
+//   - method: Any HTTP verb ("GET", "POST", "PUT", "PATCH", "DELETE", ...).
 //   - url: Fully constructed URL (already includes version when needed).
 //   - auth: Whether the request requires an Authorization header.
 //   - otpRequired: Whether X-TOTP should be sent for this specific request.
-//   - body: For GET, serialized into URL params; for POST, JSON-encoded.
-//   - result: Optional pointer to the output struct into which JSON is unmarshaled.
+//   - body: For GET/HEAD/DELETE/OPTIONS, serialized into URL params; for
+//     POST/PUT/PATCH (and other verbs), JSON-encoded.
+//   - result: Optional pointer to the output struct into which JSON is decoded.
+//   - opts: Optional per-call settings such as WithContext and WithHeader.
 //
 // Returns:
 //   - nil on success.
@@ -361,15 +658,25 @@ func (c *Client) handleAutoRefresh() error {
 //   - *APIError when Nobitex returns status != 2xx.
 //
 // Behavior:
-//   - GET: struct → ?a=b&c=d via StructToURLParams.
-//   - POST: struct → JSON in request body.
+//   - GET, HEAD, DELETE, OPTIONS: struct → ?a=b&c=d via StructToURLParams;
+//     url.Values, map[string][]string and map[string]string are also
+//     accepted as-is.
+//   - POST, PUT, PATCH: struct → JSON in request body;
+//     *MultipartBody → multipart/form-data.
+//   - The method is matched case-insensitively.
 //   - If auth=true:
 //   - handleAutoRefresh() is executed when AutoRefresh is enabled.
 //   - assertAuth() ensures ApiKey is set.
 //   - User-Agent and Authorization headers are required.
 //   - X-TOTP header is added when otpRequired=true.
+//   - Headers supplied through WithHeader are applied last.
 //   - On error HTTP status, parseErrorResponse() maps Nobitex JSON error objects
 //     into APIError (fields: status, code, message, detail).
+//   - A maintenance response (maintenance error code or 503 maintenance
+//     page) degrades the client: later calls fail fast with an error
+//     wrapping ErrMaintenance until a background probe sees recovery.
+//   - Successful responses are decoded directly from the connection with a
+//     json.Decoder; the body is never buffered in full.
 //
 // Dependencies:
 //   - StructToURLParams
@@ -381,7 +688,7 @@ func (c *Client) handleAutoRefresh() error {
 //   - "failed to marshal request body"
 //   - "failed to convert struct to URL params"
 //   - "failed to send request"
-//   - "failed to unmarshal response"
+//   - "failed to decode response"
 //   - APIError (status, code, message, detail)
 //
 // Example:
@@ -391,13 +698,100 @@ func (c *Client) handleAutoRefresh() error {
 //	if err != nil {
 //	    return err
 //	}
-func (c *Client) Request(method string, url string, auth bool, otpRequired bool, body interface{}, result interface{}) error {
+func (c *Client) Request(method string, url string, auth bool, otpRequired bool, body interface{}, result interface{}, opts ...RequestOption) error {
+	ro := resolveRequestOptions(opts)
+	ro.timeout = c.timeout
+	return c.send(method, url, auth, otpRequired, body, decodeInto(result), ro)
+}
+
+// RequestStream sends an HTTP request exactly like Request, but hands the raw
+// response body of a successful (2xx) response to handle instead of decoding
+// it into a result struct.
+//
+// Parameters:
+//   - method, url, auth, otpRequired, body: see Request.
+//   - handle: Callback receiving the response body. It is only invoked for
+//     2xx responses and must not retain the reader after returning.
+//
+// Returns:
+//   - nil on success.
+//   - *RequestError when the request fails or handle returns an error.
+//   - *APIError when Nobitex returns status != 2xx.
+//
+// Behavior:
+//   - Used for large listings (full trade history, all orderbooks) where the
+//     caller consumes elements one by one, keeping peak memory independent of
+//     the response size.
+//   - Error bodies are still read in full so parseErrorResponse() can
+//     inspect them.
+//
+// Example:
+//
+//	err := client.RequestStream("GET", url, false, false, nil, func(r io.Reader) error {
+//	    return u.DecodeObjectFields(r, func(key string, dec *json.Decoder) error {
+//	        ...
+//	    })
+//	})
+func (c *Client) RequestStream(method string, url string, auth bool, otpRequired bool, body interface{}, handle func(r io.Reader) error, opts ...RequestOption) error {
+	return c.send(method, url, auth, otpRequired, body, handle, resolveRequestOptions(opts))
+}
+
+// methodUsesQuery reports whether method carries its parameters in the query
+// string (GET, HEAD, DELETE, OPTIONS) rather than in a request body
+// (POST, PUT, PATCH and any other verb).
+func methodUsesQuery(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodDelete, http.MethodOptions:
+		return true
+	default:
+		return false
+	}
+}
+
+// decodeInto returns a response handler that JSON-decodes the body into
+// result. A nil result discards the body; an empty body fails with
+// ErrEmptyResponse instead of leaving result at its zero value.
+func decodeInto(result interface{}) func(r io.Reader) error {
+	return func(r io.Reader) error {
+		if result == nil {
+			return nil
+		}
+		err := json.NewDecoder(r).Decode(result)
+		if err == io.EOF {
+			return ErrEmptyResponse
+		}
+		return err
+	}
+}
+
+// send is the context-aware core shared by Request and RequestStream. It
+// prepares, authenticates and executes the request and passes the body of a
+// successful response to handle.
+func (c *Client) send(method string, url string, auth bool, otpRequired bool, body interface{}, handle func(r io.Reader) error, ro *requestOptions) error {
+	if !c.lifecycle.begin() {
+		return closedError()
+	}
+	defer c.lifecycle.end()
+
+	if !ro.bypassMaintenance {
+		if active, _ := c.InMaintenance(); active {
+			return maintenanceError()
+		}
+	}
+
+	method = strings.ToUpper(method)
+	if err := c.checkReadOnly(method, url); err != nil {
+		return err
+	}
+
 	var reqBody []byte
 	var err error
 
-	if method == "GET" {
+	contentType := "application/json"
+
+	if methodUsesQuery(method) {
 		if body != nil {
-			urlParams, err := u.StructToURLParams(body)
+			urlParams, err := u.ToURLParams(body)
 			if err != nil {
 				return &RequestError{
 					GoNobitexError: GoNobitexError{
@@ -407,12 +801,23 @@ func (c *Client) Request(method string, url string, auth bool, otpRequired bool,
 					Operation: "preparing request parameters",
 				}
 			}
-			url += "?" + urlParams
+			if urlParams != "" {
+				if strings.Contains(url, "?") {
+					url += "&" + urlParams
+				} else {
+					url += "?" + urlParams
+				}
+			}
 		}
-	}
-
-	if method == "POST" {
-		if body != nil {
+	} else {
+		if _, ok := asMultipart(body); ok {
+			if body, err = encodeMultipart(body); err != nil {
+				return err
+			}
+		}
+		if encoded, ok := body.(*encodedMultipart); ok {
+			reqBody, contentType = encoded.data, encoded.contentType
+		} else if body != nil {
 			reqBody, err = json.Marshal(body)
 			if err != nil {
 				return &RequestError{
@@ -426,7 +831,7 @@ func (c *Client) Request(method string, url string, auth bool, otpRequired bool,
 		}
 	}
 
-	req, err := http.NewRequest(method, url, bytes.NewBuffer(reqBody))
+	req, err := http.NewRequestWithContext(ro.ctx, method, url, bytes.NewBuffer(reqBody))
 	if err != nil {
 		return &RequestError{
 			GoNobitexError: GoNobitexError{
@@ -437,7 +842,7 @@ func (c *Client) Request(method string, url string, auth bool, otpRequired bool,
 		}
 	}
 
-	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Content-Type", contentType)
 
 	if auth {
 		if c.AutoRefresh {
@@ -479,45 +884,107 @@ func (c *Client) Request(method string, url string, auth bool, otpRequired bool,
 		req.Header.Set("X-TOTP", c.OtpCode)
 	}
 
-	resp, err := c.HttpClient.Do(req)
-	if err != nil {
+	for key, vals := range ro.headers {
+		req.Header.Del(key)
+		for _, val := range vals {
+			req.Header.Add(key, val)
+		}
+	}
+
+	if err := c.rateLimits.wait(ro.ctx, url); err != nil {
 		return &RequestError{
 			GoNobitexError: GoNobitexError{
-				Message: "failed to send request",
+				Message: "rate limit wait aborted",
 				Err:     err,
 			},
-			Operation: "sending request",
+			Operation: "waiting for rate limit",
 		}
 	}
-	defer func(Body io.ReadCloser) {
-		_ = Body.Close()
-	}(resp.Body)
 
-	respBody, err := io.ReadAll(resp.Body)
+	if err := c.limiter.acquire(ro.ctx); err != nil {
+		return &RequestError{
+			GoNobitexError: GoNobitexError{
+				Message: "failed to acquire request slot",
+				Err:     err,
+			},
+			Operation: "waiting for request slot",
+		}
+	}
+	defer c.limiter.release()
+
+	if ro.timeout > 0 {
+		ctx, cancel := context.WithTimeout(ro.ctx, ro.timeout)
+		defer cancel()
+		req = req.WithContext(ctx)
+	}
+
+	if c.deduplicate && method == "GET" && !auth && !otpRequired && len(ro.headers) == 0 {
+		return c.executeShared(req, handle, ro)
+	}
+
+	return c.execute(req, handle, ro)
+}
+
+// execute performs a prepared request, maps non-2xx responses to *APIError
+// and passes the body of a successful response to handle.
+func (c *Client) execute(req *http.Request, handle func(r io.Reader) error, ro *requestOptions) (err error) {
+	req = c.traceConnections(req)
+
+	debug := c.debug || ro.debug
+	if debug {
+		c.dumpRequest(req)
+	}
+
+	sent := time.Now()
+	statusCode := 0
+	defer func() {
+		c.recordRequest(req, statusCode, time.Since(sent), err)
+	}()
+
+	resp, err := c.HttpClient.Do(req)
 	if err != nil {
 		return &RequestError{
 			GoNobitexError: GoNobitexError{
-				Message: "failed to read response body",
+				Message: "failed to send request",
 				Err:     err,
 			},
-			Operation: "reading response",
+			Operation: "sending request",
 		}
 	}
+	defer func(Body io.ReadCloser) {
+		// Drain what the decoder left behind (e.g. a trailing newline) so the
+		// connection can be returned to the pool and reused.
+		_, _ = io.Copy(io.Discard, io.LimitReader(Body, maxDrainBytes))
+		_ = Body.Close()
+	}(resp.Body)
 
-	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return parseErrorResponse(resp.StatusCode, respBody)
+	statusCode = resp.StatusCode
+	c.recordProtocol(resp)
+	c.rateLimits.observe(req.URL.String(), resp)
+	c.clock.observe(resp, sent)
+
+	if debug {
+		c.dumpResponse(resp)
 	}
 
-	if result != nil {
-		if err = json.Unmarshal(respBody, result); err != nil {
+	bodyLimit := responseBodyLimit(c.maxBodySize)
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		respBody, err := readErrorBody(resp.Body, bodyLimit)
+		if err != nil {
 			return &RequestError{
 				GoNobitexError: GoNobitexError{
-					Message: "failed to unmarshal response",
+					Message: "failed to read response body",
 					Err:     err,
 				},
-				Operation: "parsing response",
+				Operation: "reading response",
 			}
 		}
+		return c.markMaintenance(resp.StatusCode, respBody, parseErrorResponse(resp.StatusCode, respBody))
+	}
+
+	if err := callHandle(handle, newLimitedBody(resp.Body, bodyLimit)); err != nil {
+		return decodeError(err)
 	}
 
 	return nil
@@ -527,13 +994,15 @@ func (c *Client) Request(method string, url string, auth bool, otpRequired bool,
 // createApiURI() and delegates the actual HTTP call to Request().
 //
 // Parameters:
-//   - method: HTTP method ("GET", "POST").
+//   - method: HTTP method ("GET", "POST", "PUT", "PATCH", "DELETE").
 //   - endpoint: The endpoint path, such as "/market/orders/add".
 //   - version: Nobitex version string ("v2", "v3"). May be empty.
 //   - auth: Whether this call requires Authorization: Token <key>.
 //   - otpRequired: Whether this endpoint requires X-TOTP.
-//   - body: Struct for GET params or POST JSON body.
+//   - body: Struct (or url.Values / map[string]string) for GET params,
+//     or POST JSON body.
 //   - result: Destination struct for response JSON.
+//   - opts: Optional per-call RequestOption values.
 //
 // Returns:
 //   - nil on success.
@@ -542,14 +1011,75 @@ func (c *Client) Request(method string, url string, auth bool, otpRequired bool,
 // Behavior:
 //   - Constructs URL = BaseUrl + "/api/{version}/{endpoint}".
 //   - Passes all fields to Request() unchanged.
+//   - When ClientOptions.BaseUrls lists mirrors, failed calls are retried
+//     against the next healthy base URL (see withFailover).
+//   - When ClientOptions.Retry is set, rate-limited (429), 5xx and
+//     transport failures are retried with jittered backoff (see withRetry).
 //
 // Example:
 //
 //	var stats t.Tickers
 //	err := client.ApiRequest("GET", "/market/stats", "", false, false, params, &stats)
-func (c *Client) ApiRequest(method, endpoint string, version string, auth bool, otpRequired bool, body interface{}, result interface{}) error {
-	url := c.createApiURI(endpoint, version)
-	return c.Request(method, url, auth, otpRequired, body, result)
+//
+//	// One-off call without a params struct:
+//	err = client.ApiRequest("GET", "/market/stats", "", false, false,
+//	    map[string]string{"srcCurrency": "btc", "dstCurrency": "rls"}, &stats)
+func (c *Client) ApiRequest(method, endpoint string, version string, auth bool, otpRequired bool, body interface{}, result interface{}, opts ...RequestOption) error {
+	// Unlike streams, a decoded response is bounded by the client timeout
+	opts = append([]RequestOption{func(o *requestOptions) { o.timeout = c.timeout }}, opts...)
+
+	if !c.strictStatus {
+		return c.ApiRequestStream(method, endpoint, version, auth, otpRequired, body, decodeInto(result), opts...)
+	}
+
+	// Strict mode keeps the body so a failing status can be reported with
+	// its code and message
+	var raw []byte
+	err := c.ApiRequestStream(method, endpoint, version, auth, otpRequired, body, func(r io.Reader) error {
+		var err error
+		if raw, err = io.ReadAll(r); err != nil {
+			return err
+		}
+		if result == nil {
+			return nil
+		}
+		if len(bytes.TrimSpace(raw)) == 0 {
+			return ErrEmptyResponse
+		}
+		return json.Unmarshal(raw, result)
+	}, opts...)
+	if err != nil {
+		return err
+	}
+	return checkResultStatus(result, raw)
+}
+
+// ApiRequestStream is the streaming counterpart of ApiRequest. It builds the
+// URL using createApiURI() and delegates to RequestStream().
+//
+// Parameters:
+//   - method, endpoint, version, auth, otpRequired, body: see ApiRequest.
+//   - handle: Callback receiving the body of a successful response.
+//
+// Returns:
+//   - nil on success.
+//   - See RequestStream() for structured errors.
+func (c *Client) ApiRequestStream(method, endpoint string, version string, auth bool, otpRequired bool, body interface{}, handle func(r io.Reader) error, opts ...RequestOption) error {
+	ro := resolveRequestOptions(opts)
+	method = strings.ToUpper(method)
+
+	// Multipart file readers can only be consumed once
+	body, err := encodeMultipart(body)
+	if err != nil {
+		return err
+	}
+
+	return c.withRetry(method, ro, func() error {
+		return c.withFailover(method, ro, func(base string) error {
+			url := joinApiURI(base, endpoint, version)
+			return c.send(method, url, auth, otpRequired, body, handle, ro)
+		})
+	})
 }
 
 // Authenticate logs in to Nobitex using username, password, captcha="api",
@@ -592,7 +1122,7 @@ func (c *Client) ApiRequest(method, endpoint string, version string, auth bool,
 //	    return err
 //	}
 //	fmt.Println("API key:", resp.Key)
-func (c *Client) Authenticate(Username, Password string) (*t.AuthenticationResponse, error) {
+func (c *Client) Authenticate(Username, Password string, opts ...RequestOption) (*t.AuthenticationResponse, error) {
 	if Username == "" || Password == "" {
 		return nil, &GoNobitexError{
 			Message: "Username and/or password are empty",
@@ -608,7 +1138,7 @@ func (c *Client) Authenticate(Username, Password string) (*t.AuthenticationRespo
 	}
 
 	var authResponse t.AuthenticationResponse
-	err := c.ApiRequest("POST", "/auth/login/", "", false, true, reqBody, &authResponse)
+	err := c.ApiRequest("POST", "/auth/login/", "", false, true, reqBody, &authResponse, opts...)
 
 	if err != nil {
 		// Check for specific API errors here
@@ -649,6 +1179,7 @@ func (c *Client) Authenticate(Username, Password string) (*t.AuthenticationRespo
 // Behavior:
 //   - No authentication required.
 //   - Calls ApiRequest("GET", "options", "v2").
+//   - Always hits the network; use Config() for a cached copy.
 //
 // Example:
 //
@@ -657,9 +1188,9 @@ func (c *Client) Authenticate(Username, Password string) (*t.AuthenticationRespo
 //	    log.Fatal(err)
 //	}
 //	fmt.Println(cfg.Nobitex.ActiveCurrencies)
-func (c *Client) GetNobitexConfig() (*t.Config, error) {
+func (c *Client) GetNobitexConfig(opts ...RequestOption) (*t.Config, error) {
 	var config *t.Config
-	err := c.ApiRequest("GET", "/options", "v2", false, false, nil, &config)
+	err := c.ApiRequest("GET", "/options", "v2", false, false, nil, &config, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -686,18 +1217,27 @@ func (c *Client) GetNobitexConfig() (*t.Config, error) {
 // Behavior:
 //   - No authentication required.
 //   - Serializes params as query string.
+//   - Served from the market data cache when ClientOptions.MarketDataCacheTTL
+//     is set; cached responses are shared and must not be modified.
 //
 // Example:
 //
 //	stats, err := client.GetTickers(t.GetTickersParams{SrcCurrency:"btc",DstCurrency:"usdt"})
 //	if err != nil { ... }
 //	fmt.Println(stats.Stats["BTCUSDT"].Latest)
-func (c *Client) GetTickers(params t.GetTickersParams) (*t.Tickers, error) {
+func (c *Client) GetTickers(params t.GetTickersParams, opts ...RequestOption) (*t.Tickers, error) {
+	key := "tickers:" + params.SrcCurrency + "/" + params.DstCurrency
+	if cached, ok := c.shared().market.get(key); ok {
+		return cached.(*t.Tickers), nil
+	}
+
 	var tickers *t.Tickers
-	err := c.ApiRequest("GET", "/market/stats", "", false, false, params, &tickers)
+	err := c.ApiRequest("GET", "/market/stats", "", false, false, params, &tickers, opts...)
 	if err != nil {
 		return nil, err
 	}
+
+	c.shared().market.set(key, tickers)
 	return tickers, nil
 }
 
@@ -713,7 +1253,7 @@ func (c *Client) GetTickers(params t.GetTickersParams) (*t.Tickers, error) {
 // Returns:
 //   - *t.OrderBook containing:
 //     Status
-//     LastUpdate
+//     LastUpdate (unix ms; see LastUpdateTime and Age)
 //     LastTradePrice
 //     Asks [][]string
 //     Bids [][]string
@@ -721,20 +1261,78 @@ func (c *Client) GetTickers(params t.GetTickersParams) (*t.Tickers, error) {
 // Behavior:
 //   - No authentication required.
 //   - Uses version "v3" (Nobitex newest orderbook specification).
+//   - Served from the market data cache when ClientOptions.MarketDataCacheTTL
+//     is set; cached responses are shared and must not be modified.
 //
 // Example:
 //
 //	ob, _ := client.GetOrderBook("BTCUSDT")
 //	fmt.Println(ob.Asks[0], ob.Bids[0])
-func (c *Client) GetOrderBook(symbol string) (*t.OrderBook, error) {
+func (c *Client) GetOrderBook(symbol string, opts ...RequestOption) (*t.OrderBook, error) {
+	symbol, err := NormalizeSymbol(symbol)
+	if err != nil {
+		return nil, err
+	}
+
+	key := "orderbook:" + symbol
+	if cached, ok := c.shared().market.get(key); ok {
+		return cached.(*t.OrderBook), nil
+	}
+
 	var orderBook *t.OrderBook
-	err := c.ApiRequest("GET", fmt.Sprintf("/orderbook/%s", symbol), "v3", false, false, nil, &orderBook)
+	err = c.ApiRequest("GET", fmt.Sprintf("/orderbook/%s", symbol), "v3", false, false, nil, &orderBook, opts...)
 	if err != nil {
 		return nil, err
 	}
+
+	c.shared().market.set(key, orderBook)
 	return orderBook, nil
 }
 
+// StreamAllOrderBooks retrieves the order books of every market in a single
+// call and delivers them one market at a time.
+//
+// Endpoint:
+//
+//	GET /api/v3/orderbook/all
+//
+// Parameters:
+//   - fn: Callback invoked once per market with the symbol (e.g. "BTCIRT")
+//     and its decoded order book. Returning an error stops the stream.
+//
+// Returns:
+//   - nil once every market has been delivered.
+//   - The first error returned by fn, wrapped in a *RequestError.
+//   - See Request() for transport and API errors.
+//
+// Behavior:
+//   - No authentication required.
+//   - The response is decoded incrementally, so only one order book is held
+//     in memory at a time regardless of how many markets Nobitex lists.
+//   - The top-level "status" field is skipped; OrderBook.Status is left empty.
+//
+// Example:
+//
+//	err := client.StreamAllOrderBooks(func(symbol string, ob t.OrderBook) error {
+//	    fmt.Println(symbol, ob.LastTradePrice)
+//	    return nil
+//	})
+func (c *Client) StreamAllOrderBooks(fn func(symbol string, orderBook t.OrderBook) error, opts ...RequestOption) error {
+	return c.ApiRequestStream("GET", "/orderbook/all", "v3", false, false, nil, func(r io.Reader) error {
+		return u.DecodeObjectFields(r, func(key string, dec *json.Decoder) error {
+			if key == "status" {
+				return u.SkipValue(dec)
+			}
+
+			var orderBook t.OrderBook
+			if err := dec.Decode(&orderBook); err != nil {
+				return err
+			}
+			return fn(key, orderBook)
+		})
+	}, opts...)
+}
+
 // GetRecentTrades retrieves recent trade executions for a market.
 //
 // Endpoint:
@@ -759,9 +1357,14 @@ func (c *Client) GetOrderBook(symbol string) (*t.OrderBook, error) {
 //
 //	trades, _ := client.GetRecentTrades("BTCUSDT")
 //	fmt.Println(trades[0].Price, trades[0].Type)
-func (c *Client) GetRecentTrades(symbol string) (*t.Trades, error) {
+func (c *Client) GetRecentTrades(symbol string, opts ...RequestOption) (*t.Trades, error) {
+	symbol, err := NormalizeSymbol(symbol)
+	if err != nil {
+		return nil, err
+	}
+
 	var trades *t.Trades
-	err := c.ApiRequest("GET", fmt.Sprintf("/trades/%s", symbol), "v2", false, false, nil, &trades)
+	err = c.ApiRequest("GET", fmt.Sprintf("/trades/%s", symbol), "v2", false, false, nil, &trades, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -805,9 +1408,94 @@ func (c *Client) GetRecentTrades(symbol string) (*t.Trades, error) {
 // Errors:
 //   - APIError (status, code, message, detail)
 //   - RequestError (network/JSON issues)
-func (c *Client) GetWallets(params t.GetWalletParams) (*t.Wallets, error) {
+func (c *Client) GetWallets(params t.GetWalletParams, opts ...RequestOption) (*t.Wallets, error) {
 	var wallets *t.Wallets
-	err := c.ApiRequest("GET", "/wallets", "v2", true, false, params, &wallets)
+	err := c.ApiRequest("GET", "/wallets", "v2", true, false, params, &wallets, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return wallets, nil
+}
+
+// GetWalletsList retrieves the authenticated user's wallets from the
+// legacy listing endpoint, for accounts where /v2/wallets is unavailable
+// or incomplete.
+//
+// Endpoint:
+//
+//	POST /users/wallets/list
+//
+// Parameters:
+//   - params: TradeType selects "spot" or "margin" wallets. Currencies is
+//     applied client-side, as the legacy endpoint lists every wallet.
+//
+// Returns:
+//   - *t.Wallets in the same shape as GetWallets: the legacy array is
+//     keyed by lower-case currency and blockedBalance becomes Blocked.
+//
+// Behavior:
+//   - Requires authentication.
+//
+// Example:
+//
+//	wallets, err := client.GetWalletsList(t.GetWalletParams{})
+//	if err != nil { ... }
+//	fmt.Println(wallets.Wallets["rls"].Balance)
+func (c *Client) GetWalletsList(params t.GetWalletParams, opts ...RequestOption) (*t.Wallets, error) {
+	body := struct {
+		TradeType string `json:"type,omitempty"`
+	}{TradeType: params.TradeType}
+
+	var wallets *t.Wallets
+	err := c.ApiRequest("POST", "/users/wallets/list", "", true, false, body, &wallets, idempotent(opts)...)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(params.Currencies) > 0 && wallets != nil {
+		filtered := make(map[string]t.Wallet, len(params.Currencies))
+		for _, currency := range params.Currencies {
+			currency = strings.ToLower(currency)
+			if wallet, ok := wallets.Wallets[currency]; ok {
+				filtered[currency] = wallet
+			}
+		}
+		wallets.Wallets = filtered
+	}
+	return wallets, nil
+}
+
+// GetWalletsV3 retrieves the authenticated user's wallets from the v3
+// wallets endpoint, which adds rial valuation and daily change to the
+// v2 fields.
+//
+// Endpoint:
+//
+//	GET /v3/wallets
+//
+// Parameters:
+//   - params: The same filters as GetWallets (currencies, trade type).
+//
+// Returns:
+//   - *t.WalletsV3 keyed by currency symbol, with:
+//     Balance / Blocked / ActiveBalance
+//     RialBalance / RialBalanceSell
+//     DailyChange
+//
+// Behavior:
+//   - Requires authentication.
+//   - GetWallets keeps using the v2 endpoint; WalletsV3.V2() converts a v3
+//     response for code written against it.
+//
+// Example:
+//
+//	wallets, err := client.GetWalletsV3(t.GetWalletParams{})
+//	if err != nil { ... }
+//	btc := wallets.Wallets["btc"]
+//	fmt.Println(btc.Balance, btc.RialBalance, btc.DailyChange)
+func (c *Client) GetWalletsV3(params t.GetWalletParams, opts ...RequestOption) (*t.WalletsV3, error) {
+	var wallets *t.WalletsV3
+	err := c.ApiRequest("GET", "/wallets", "v3", true, false, params, &wallets, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -842,6 +1530,12 @@ func (c *Client) GetWallets(params t.GetWalletParams) (*t.Wallets, error) {
 //   - Requires authentication.
 //   - No TOTP required for order placement (otpRequired=false).
 //   - Returns server-evaluated matched/unmatched amounts, fees, timestamps.
+//   - Publishes OrderPlaced or OrderRejected on Client.Events().
+//   - The call is only retried when ClientOrderId is set, so a timeout
+//     can never double-submit. An empty 2xx response is never retried,
+//     since the order was already accepted.
+//   - With ClientOptions.RiskLimits, orders violating a limit fail with
+//     a *RiskError without being sent.
 //
 // Example:
 //
@@ -853,10 +1547,25 @@ func (c *Client) GetWallets(params t.GetWalletParams) (*t.Wallets, error) {
 //	    Amount:      "0.01",
 //	    Price:       "1500000000",
 //	})
-func (c *Client) CreateOrder(params t.CreateOrderParams) (*t.OrderStatus, error) {
+func (c *Client) CreateOrder(params t.CreateOrderParams, opts ...RequestOption) (*t.OrderStatus, error) {
+	if params.ClientOrderId != "" {
+		opts = idempotent(opts)
+	}
+
+	started := time.Now()
+	reservation, err := c.checkRisk(params, opts)
+	if err != nil {
+		c.audit(AuditCreateOrder, started, params, nil, err)
+		c.publishCreateOrder(params, nil, err)
+		return nil, err
+	}
+
 	var orderStatus *t.OrderStatus
-	err := c.ApiRequest("POST", "/market/orders/add", "", true, false, params, &orderStatus)
+	err = c.ApiRequest("POST", "/market/orders/add", "", true, false, params, &orderStatus, opts...)
+	c.audit(AuditCreateOrder, started, params, orderStatus, err)
+	c.publishCreateOrder(params, orderStatus, err)
 	if err != nil {
+		c.riskState.release(reservation)
 		return nil, err
 	}
 	return orderStatus, nil
@@ -880,18 +1589,22 @@ func (c *Client) CreateOrder(params t.CreateOrderParams) (*t.OrderStatus, error)
 // Behavior:
 //   - Requires authentication.
 //   - The SDK enforces params.Status="canceled" automatically.
+//   - Publishes OrderCanceled on Client.Events().
 //
 // Example:
 //
 //	err := client.CancelOrder(t.CancelOrderParams{Id:12345})
-func (c *Client) CancelOrder(params t.CancelOrderParams) (*t.CancelOrderResponse, error) {
+func (c *Client) CancelOrder(params t.CancelOrderParams, opts ...RequestOption) (*t.CancelOrderResponse, error) {
 	params.Status = "canceled"
 
+	started := time.Now()
 	var cancelOrderStatus *t.CancelOrderResponse
-	err := c.ApiRequest("POST", "/market/orders/update-status", "", true, false, params, &cancelOrderStatus)
+	err := c.ApiRequest("POST", "/market/orders/update-status", "", true, false, params, &cancelOrderStatus, opts...)
+	c.audit(AuditCancelOrder, started, params, cancelOrderStatus, err)
 	if err != nil {
 		return nil, err
 	}
+	c.publishCancelOrder(params)
 	return cancelOrderStatus, nil
 }
 
@@ -919,9 +1632,11 @@ func (c *Client) CancelOrder(params t.CancelOrderParams) (*t.CancelOrderResponse
 // Example:
 //
 //	err := client.CancelOrderBulk(t.CancelOrderBulkParams{Hours: 6})
-func (c *Client) CancelOrderBulk(params t.CancelOrderBulkParams) (*t.CancelOrderResponse, error) {
+func (c *Client) CancelOrderBulk(params t.CancelOrderBulkParams, opts ...RequestOption) (*t.CancelOrderResponse, error) {
+	started := time.Now()
 	var cancelOrderBulkStatus *t.CancelOrderResponse
-	err := c.ApiRequest("POST", "/market/orders/cancel-old", "", true, false, params, &cancelOrderBulkStatus)
+	err := c.ApiRequest("POST", "/market/orders/cancel-old", "", true, false, params, &cancelOrderBulkStatus, opts...)
+	c.audit(AuditCancelOrderBulk, started, params, cancelOrderBulkStatus, err)
 	if err != nil {
 		return nil, err
 	}
@@ -941,7 +1656,9 @@ func (c *Client) CancelOrderBulk(params t.CancelOrderBulkParams) (*t.CancelOrder
 //     Execution ("limit","market")
 //     TradeType ("spot","margin")
 //     SrcCurrency / DstCurrency
-//     Details, FromId, Order (sorting/id filters)
+//     Details, FromId
+//     Order (t.SortIdAsc, t.SortCreatedAtDesc, t.SortPriceAsc, ...)
+//     Page, PageSize (page-based pagination)
 //
 // Returns:
 //
@@ -961,9 +1678,62 @@ func (c *Client) CancelOrderBulk(params t.CancelOrderBulkParams) (*t.CancelOrder
 //	    SrcCurrency:"btc",
 //	    DstCurrency:"usdt",
 //	})
-func (c *Client) GetOrdersHistory(params t.GetOrdersListParams) (*t.OrdersListResponse, error) {
+func (c *Client) GetOrdersHistory(params t.GetOrdersListParams, opts ...RequestOption) (*t.OrdersListResponse, error) {
+	if err := validateOrderSort(params.Order); err != nil {
+		return nil, err
+	}
+
 	var orders *t.OrdersListResponse
-	err := c.ApiRequest("GET", "/market/orders/list", "", true, false, params, &orders)
+	err := c.ApiRequest("GET", "/market/orders/list", "", true, false, params, &orders, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return orders, nil
+}
+
+// validateOrderSort rejects sort keys Nobitex does not support before a
+// request is made.
+func validateOrderSort(sort t.OrderSort) error {
+	if !sort.Valid() {
+		return &GoNobitexError{
+			Message: fmt.Sprintf("unsupported order sort %q (want one of the t.Sort* constants)", sort),
+		}
+	}
+	return nil
+}
+
+// GetOrdersList retrieves one page of the user's orders together with the
+// pagination metadata of the listing.
+//
+// Endpoint:
+//
+//	GET /market/orders/list
+//
+// Parameters:
+//   - params: t.GetOrdersListParams (same filters as GetOrdersHistory)
+//     Page / PageSize for explicit page-based pagination
+//
+// Returns:
+//   - *t.OrderStatusList containing:
+//     Status
+//     Orders []OrdersListResponse
+//     HasNext bool
+//
+// Behavior:
+//   - Requires authentication.
+//   - From/To are not applied here; use Orders() for filtered iteration.
+//
+// Example:
+//
+//	page, _ := client.GetOrdersList(t.GetOrdersListParams{Status: "all", Page: 2, PageSize: 100})
+//	fmt.Println(len(page.Orders), page.HasNext)
+func (c *Client) GetOrdersList(params t.GetOrdersListParams, opts ...RequestOption) (*t.OrderStatusList, error) {
+	if err := validateOrderSort(params.Order); err != nil {
+		return nil, err
+	}
+
+	var orders *t.OrderStatusList
+	err := c.ApiRequest("GET", "/market/orders/list", "", true, false, params, &orders, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -990,10 +1760,14 @@ func (c *Client) GetOrdersHistory(params t.GetOrdersListParams) (*t.OrdersListRe
 // Example:
 //
 //	openOrders, _ := client.GetOpenOrders(t.GetOrdersListParams{})
-func (c *Client) GetOpenOrders(params t.GetOrdersListParams) (*t.OrdersListResponse, error) {
+func (c *Client) GetOpenOrders(params t.GetOrdersListParams, opts ...RequestOption) (*t.OrdersListResponse, error) {
+	if err := validateOrderSort(params.Order); err != nil {
+		return nil, err
+	}
+
 	var orders *t.OrdersListResponse
 	params.Status = "open" // Automatically filter for active (open) orders
-	err := c.ApiRequest("GET", "/market/orders/list", "", true, false, params, &orders)
+	err := c.ApiRequest("GET", "/market/orders/list", "", true, false, params, &orders, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1024,9 +1798,9 @@ func (c *Client) GetOpenOrders(params t.GetOrdersListParams) (*t.OrdersListRespo
 // Example:
 //
 //	st, _ := client.GetOrderStatus(t.GetOrderStatusParams{Id: 12345})
-func (c *Client) GetOrderStatus(params t.GetOrderStatusParams) (*t.OrderStatus, error) {
+func (c *Client) GetOrderStatus(params t.GetOrderStatusParams, opts ...RequestOption) (*t.OrderStatus, error) {
 	var orders *t.OrderStatus
-	err := c.ApiRequest("POST", "/market/orders/status", "", true, false, params, &orders)
+	err := c.ApiRequest("POST", "/market/orders/status", "", true, false, params, &orders, idempotent(opts)...)
 	if err != nil {
 		return nil, err
 	}
@@ -1044,6 +1818,7 @@ func (c *Client) GetOrderStatus(params t.GetOrderStatusParams) (*t.OrderStatus,
 //     SrcCurrency
 //     DstCurrency
 //     FromId (for pagination)
+//     From / To (client-side timestamp bounds)
 //
 // Returns:
 //   - *t.UserTrades containing:
@@ -1054,6 +1829,8 @@ func (c *Client) GetOrderStatus(params t.GetOrderStatusParams) (*t.OrderStatus,
 // Behavior:
 //   - Requires authentication.
 //   - Supports pagination via FromId.
+//   - Trades outside From/To are dropped from the page; HasNext still
+//     refers to the unfiltered listing.
 //
 // Example:
 //
@@ -1061,11 +1838,277 @@ func (c *Client) GetOrderStatus(params t.GetOrderStatusParams) (*t.OrderStatus,
 //	    SrcCurrency:"btc",
 //	    DstCurrency:"usdt",
 //	})
-func (c *Client) GetUserTrades(params t.GetUserTradesParams) (*t.UserTrades, error) {
+func (c *Client) GetUserTrades(params t.GetUserTradesParams, opts ...RequestOption) (*t.UserTrades, error) {
+	trades, err := c.userTradesPage(params, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	if !params.From.IsZero() || !params.To.IsZero() {
+		filtered := trades.Trades[:0]
+		for _, trade := range trades.Trades {
+			if inTimeRange(trade.Timestamp, params.From, params.To) {
+				filtered = append(filtered, trade)
+			}
+		}
+		trades.Trades = filtered
+	}
+	return trades, nil
+}
+
+// userTradesPage fetches one unfiltered page of /market/trades/list. The
+// pagination helpers use it so client-side date filtering never hides the
+// trades that advance the FromId cursor.
+func (c *Client) userTradesPage(params t.GetUserTradesParams, opts ...RequestOption) (*t.UserTrades, error) {
 	var trades *t.UserTrades
-	err := c.ApiRequest("GET", "/market/trades/list", "", true, false, params, &trades)
+	err := c.ApiRequest("GET", "/market/trades/list", "", true, false, params, &trades, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return trades, nil
 }
+
+// inTimeRange reports whether ts lies within [from, to]. Zero bounds are open.
+func inTimeRange(ts, from, to time.Time) bool {
+	if !from.IsZero() && ts.Before(from) {
+		return false
+	}
+	if !to.IsZero() && ts.After(to) {
+		return false
+	}
+	return true
+}
+
+// StreamUserTrades retrieves one page of the authenticated user's trade
+// history and delivers the trades one at a time instead of materializing
+// the whole page.
+//
+// Endpoint:
+//
+//	GET /market/trades/list
+//
+// Parameters:
+//   - params: t.GetUserTradesParams (same filters as GetUserTrades).
+//   - fn: Callback invoked for every trade in response order. Returning an
+//     error stops the stream.
+//
+// Returns:
+//   - hasNext: The "hasNext" flag of the page, for manual pagination.
+//   - An error if the request fails or fn returns an error.
+//
+// Behavior:
+//   - Requires authentication.
+//   - Decodes the "trades" array element by element, keeping memory flat for
+//     long-running bots that walk the full history.
+//
+// Example:
+//
+//	hasNext, err := client.StreamUserTrades(t.GetUserTradesParams{}, func(tr t.UserTradeResponse) error {
+//	    fmt.Println(tr.Id, tr.Price)
+//	    return nil
+//	})
+func (c *Client) StreamUserTrades(params t.GetUserTradesParams, fn func(trade t.UserTradeResponse) error, opts ...RequestOption) (bool, error) {
+	var hasNext bool
+	err := c.ApiRequestStream("GET", "/market/trades/list", "", true, false, params, func(r io.Reader) error {
+		return u.DecodeObjectFields(r, func(key string, dec *json.Decoder) error {
+			switch key {
+			case "trades":
+				return u.DecodeArrayElements(dec, func(trade t.UserTradeResponse) error {
+					if !inTimeRange(trade.Timestamp, params.From, params.To) {
+						return nil
+					}
+					return fn(trade)
+				})
+			case "hasNext":
+				return dec.Decode(&hasNext)
+			default:
+				return u.SkipValue(dec)
+			}
+		})
+	}, opts...)
+	if err != nil {
+		return false, err
+	}
+	return hasNext, nil
+}
+
+// GetDeposits retrieves one page of the authenticated user's deposits.
+//
+// Endpoint:
+//
+//	POST /users/wallets/deposits/list
+//
+// Parameters:
+//   - params: t.GetDepositsParams
+//     Wallet (optional wallet id filter)
+//     Page / PageSize
+//
+// Returns:
+//   - *t.Deposits containing:
+//     Status
+//     Deposits []Deposit
+//     HasNext bool
+//
+// Behavior:
+//   - Requires authentication.
+//   - Use Deposits() to iterate over every page.
+//
+// Example:
+//
+//	deposits, _ := client.GetDeposits(t.GetDepositsParams{Page: 1})
+//	for _, d := range deposits.Deposits {
+//	    fmt.Println(d.Currency, d.Amount, d.IsConfirmed)
+//	}
+func (c *Client) GetDeposits(params t.GetDepositsParams, opts ...RequestOption) (*t.Deposits, error) {
+	var deposits *t.Deposits
+	err := c.ApiRequest("POST", "/users/wallets/deposits/list", "", true, false, params, &deposits, idempotent(opts)...)
+	if err != nil {
+		return nil, err
+	}
+	return deposits, nil
+}
+
+// InitiateShetabDeposit starts a rial top-up through a Shetab payment
+// gateway and returns the payment page the user must complete.
+//
+// Endpoint:
+//
+//	POST /users/wallets/deposit/shetab
+//
+// Parameters:
+//   - params: t.InitiateShetabDepositParams
+//     Amount (rials)
+//     SelectedCard (verified bank card id)
+//
+// Returns:
+//   - *t.ShetabDeposit containing:
+//     Status
+//     DepositId
+//     RedirectUrl
+//
+// Behavior:
+//   - Requires authentication.
+//   - The deposit is only credited once the user pays on RedirectUrl; track
+//     it with GetRialDepositStatus.
+//
+// Example:
+//
+//	dep, err := client.InitiateShetabDeposit(t.InitiateShetabDepositParams{
+//	    Amount:       10_000_000,
+//	    SelectedCard: 7,
+//	})
+//	fmt.Println("pay at:", dep.RedirectUrl)
+func (c *Client) InitiateShetabDeposit(params t.InitiateShetabDepositParams, opts ...RequestOption) (*t.ShetabDeposit, error) {
+	var deposit *t.ShetabDeposit
+	err := c.ApiRequest("POST", "/users/wallets/deposit/shetab", "", true, false, params, &deposit, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return deposit, nil
+}
+
+// GetRialDepositStatus retrieves the state of a rial deposit initiated
+// with InitiateShetabDeposit.
+//
+// Endpoint:
+//
+//	POST /users/wallets/deposit/shetab/status
+//
+// Parameters:
+//   - params: t.GetRialDepositStatusParams
+//     DepositId
+//
+// Returns:
+//   - *t.RialDepositStatus containing:
+//     Status
+//     Deposit → RialDeposit (Status, Fee, TrackingCode, ...)
+//
+// Behavior:
+//   - Requires authentication.
+//
+// Example:
+//
+//	st, _ := client.GetRialDepositStatus(t.GetRialDepositStatusParams{DepositId: dep.DepositId})
+//	fmt.Println(st.Deposit.Status)
+func (c *Client) GetRialDepositStatus(params t.GetRialDepositStatusParams, opts ...RequestOption) (*t.RialDepositStatus, error) {
+	var status *t.RialDepositStatus
+	err := c.ApiRequest("POST", "/users/wallets/deposit/shetab/status", "", true, false, params, &status, idempotent(opts)...)
+	if err != nil {
+		return nil, err
+	}
+	return status, nil
+}
+
+// GetWithdrawals retrieves one page of the authenticated user's withdrawals.
+//
+// Endpoint:
+//
+//	POST /users/wallets/withdraws/list
+//
+// Parameters:
+//   - params: t.GetWithdrawalsParams
+//     Wallet (optional wallet id filter)
+//     Page / PageSize
+//
+// Returns:
+//   - *t.Withdrawals containing:
+//     Status
+//     Withdrawals []Withdrawal
+//     HasNext bool
+//
+// Behavior:
+//   - Requires authentication.
+//   - Use Withdrawals() to iterate over every page.
+//
+// Example:
+//
+//	withdrawals, _ := client.GetWithdrawals(t.GetWithdrawalsParams{})
+//	for _, w := range withdrawals.Withdrawals {
+//	    fmt.Println(w.Id, w.Status, w.TxHash)
+//	}
+func (c *Client) GetWithdrawals(params t.GetWithdrawalsParams, opts ...RequestOption) (*t.Withdrawals, error) {
+	var withdrawals *t.Withdrawals
+	err := c.ApiRequest("POST", "/users/wallets/withdraws/list", "", true, false, params, &withdrawals, idempotent(opts)...)
+	if err != nil {
+		return nil, err
+	}
+	return withdrawals, nil
+}
+
+// GetWithdrawal retrieves the current state of a single withdrawal.
+//
+// Endpoint:
+//
+//	GET /withdraws/{id}
+//
+// Parameters:
+//   - id: Withdrawal.Id, as returned by GetWithdrawals.
+//
+// Returns:
+//   - *t.WithdrawalStatus containing:
+//     Status
+//     Withdrawal → Withdrawal (Status, TxHash, BlockchainUrl, ...)
+//
+// Behavior:
+//   - Requires authentication.
+//   - Use Withdrawal.State() for a coarse pending/processing/done/rejected
+//     stage and Withdrawal.Final() to stop polling.
+//
+// Example:
+//
+//	w, _ := client.GetWithdrawal(4812)
+//	if w.Withdrawal.State() == t.WithdrawalDone {
+//	    fmt.Println("sent:", w.Withdrawal.TxHash)
+//	}
+func (c *Client) GetWithdrawal(id int, opts ...RequestOption) (*t.WithdrawalStatus, error) {
+	if id <= 0 {
+		return nil, &GoNobitexError{Message: fmt.Sprintf("invalid withdrawal id %d", id)}
+	}
+
+	var withdrawal *t.WithdrawalStatus
+	err := c.ApiRequest("GET", fmt.Sprintf("/withdraws/%d", id), "", true, false, nil, &withdrawal, idempotent(opts)...)
+	if err != nil {
+		return nil, err
+	}
+	return withdrawal, nil
+}
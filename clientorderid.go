@@ -0,0 +1,37 @@
+package nobitex
+
+import (
+	"context"
+
+	t "github.com/darhelm/go-nobitex/types"
+)
+
+// GetOrderByClientID returns the status of the order placed with
+// clientOrderId.
+//
+// Example:
+//
+//	status, err := client.GetOrderByClientID(ctx, "grid-42-buy")
+//	fmt.Println(status.Order.Status, status.Order.MatchedAmount)
+func (c *Client) GetOrderByClientID(ctx context.Context, clientOrderId string, opts ...RequestOption) (*t.OrderStatus, error) {
+	if clientOrderId == "" {
+		return nil, &GoNobitexError{Message: "clientOrderId is required"}
+	}
+
+	opts = append([]RequestOption{WithContext(ctx)}, opts...)
+	return c.GetOrderStatus(t.GetOrderStatusParams{ClientOrderId: clientOrderId}, opts...)
+}
+
+// CancelOrderByClientID cancels the order placed with clientOrderId.
+//
+// Example:
+//
+//	_, err := client.CancelOrderByClientID(ctx, "grid-42-buy")
+func (c *Client) CancelOrderByClientID(ctx context.Context, clientOrderId string, opts ...RequestOption) (*t.CancelOrderResponse, error) {
+	if clientOrderId == "" {
+		return nil, &GoNobitexError{Message: "clientOrderId is required"}
+	}
+
+	opts = append([]RequestOption{WithContext(ctx)}, opts...)
+	return c.CancelOrder(t.CancelOrderParams{ClientOrderId: clientOrderId}, opts...)
+}
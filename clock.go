@@ -0,0 +1,111 @@
+package nobitex
+
+import (
+	"net/http"
+	"sync"
+	"time"
+)
+
+// clockSmoothing is the weight of a new sample in the rolling clock-offset
+// estimate. The Date header only has second precision, so single samples
+// are noisy and are blended into the running value.
+const clockSmoothing = 0.2
+
+// clockTracker maintains a rolling estimate of server time minus local time.
+type clockTracker struct {
+	mu      sync.Mutex
+	offset  time.Duration
+	samples int
+}
+
+// observe derives an offset sample from the Date header of resp. sent is
+// when the request was issued; the server is assumed to have stamped the
+// response halfway through the round trip.
+func (k *clockTracker) observe(resp *http.Response, sent time.Time) {
+	date, err := http.ParseTime(resp.Header.Get("Date"))
+	if err != nil {
+		return
+	}
+
+	received := time.Now()
+	local := sent.Add(received.Sub(sent) / 2)
+
+	// Date is truncated to the second; its expected true value is half a
+	// second later.
+	sample := date.Add(500 * time.Millisecond).Sub(local)
+
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	if k.samples == 0 {
+		k.offset = sample
+	} else {
+		k.offset += time.Duration(clockSmoothing * float64(sample-k.offset))
+	}
+	k.samples++
+}
+
+// get returns the current estimate and whether any sample was seen.
+func (k *clockTracker) get() (time.Duration, bool) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	return k.offset, k.samples > 0
+}
+
+// ClockOffset returns the estimated difference between the Nobitex server
+// clock and the local clock (server minus local). A positive value means
+// the local clock is behind.
+//
+// Behavior:
+//   - The estimate is refined from the Date header of every response, so
+//     it stays current without extra requests on an active client.
+//   - Returns 0 until the first response has been received.
+//
+// Example:
+//
+//	if d := client.ClockOffset(); d > time.Second || d < -time.Second {
+//	    log.Printf("local clock is off by %v", d)
+//	}
+func (c *Client) ClockOffset() time.Duration {
+	offset, _ := c.clock.get()
+	return offset
+}
+
+// ServerNow returns the current time on the Nobitex server as estimated
+// from ClockOffset.
+func (c *Client) ServerNow() time.Time {
+	return time.Now().Add(c.ClockOffset())
+}
+
+// GetServerTime queries Nobitex and returns the current server time.
+//
+// Returns:
+//   - The server time, corrected for half the request round trip.
+//   - An error if the request fails or the response carries no usable
+//     Date header.
+//
+// Behavior:
+//   - No authentication required.
+//   - Issues a status request and feeds its Date header into the rolling
+//     ClockOffset estimate before returning.
+//
+// Example:
+//
+//	now, err := client.GetServerTime(nobitex.WithContext(ctx))
+//	if err != nil {
+//	    return err
+//	}
+//	fmt.Println("server time:", now)
+func (c *Client) GetServerTime(opts ...RequestOption) (time.Time, error) {
+	if _, err := c.GetSystemStatus(opts...); err != nil {
+		return time.Time{}, err
+	}
+
+	if _, ok := c.clock.get(); !ok {
+		return time.Time{}, &GoNobitexError{
+			Message: "server response carried no Date header",
+		}
+	}
+	return c.ServerNow(), nil
+}
@@ -0,0 +1,170 @@
+package nobitex
+
+import (
+	"time"
+
+	t "github.com/darhelm/go-nobitex/types"
+	u "github.com/darhelm/go-nobitex/utils"
+)
+
+// sharedCaches holds the caches a client shares with its clones.
+type sharedCaches struct {
+	config configCache
+	market ttlCache
+}
+
+// shared returns the client's caches, allocating them for clients that
+// were not built by NewClient.
+func (c *Client) shared() *sharedCaches {
+	c.cachesOnce.Do(func() {
+		if c.caches == nil {
+			c.caches = &sharedCaches{}
+		}
+	})
+	return c.caches
+}
+
+// Credentials identify one Nobitex account.
+type Credentials struct {
+	Username  string
+	Password  string
+	OtpSecret string
+	OtpCode   string
+
+	// ApiKey is an already-issued API key. When set, no login is
+	// performed.
+	ApiKey string
+
+	// Remember, UserAgent and AutoRefresh default to the values of the
+	// client being cloned.
+	Remember    t.Remember
+	UserAgent   string
+	AutoRefresh *bool
+}
+
+// login applies creds, logging in when no ApiKey is given but a username,
+// password and TOTP source are.
+func (c *Client) login(creds Credentials) error {
+	c.Username = creds.Username
+	c.Password = creds.Password
+	c.OtpSecret = creds.OtpSecret
+	c.OtpCode = creds.OtpCode
+
+	if creds.ApiKey == "" && creds.Username != "" && creds.Password != "" && (creds.OtpCode != "" || creds.OtpSecret != "") {
+		if creds.OtpSecret != "" {
+			code, err := u.GenerateOtpCode(creds.OtpSecret)
+			if err != nil {
+				return err
+			}
+			c.OtpCode = code
+		}
+
+		if creds.OtpCode != "" {
+			c.OtpCode = creds.OtpCode
+		}
+
+		if _, err := c.Authenticate(creds.Username, creds.Password); err != nil {
+			return err
+		}
+	} else {
+		c.ApiKey = creds.ApiKey
+	}
+
+	c.AuthTime = time.Now()
+
+	if c.AutoRefresh {
+		if err := c.handleAutoRefresh(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Clone returns a client for another account that shares this client's
+// HTTP transport, rate limiters, concurrency limit and config/market-data
+// caches, so multi-account setups keep a single connection pool.
+//
+// Behavior:
+//   - Base URLs, failover, retry, debug, metrics and body-size settings
+//     are copied.
+//   - RiskLimits are copied and the daily volume tally is shared, so the
+//     limit covers the orders of every clone together.
+//   - Order events, tracked orders, maintenance state and the shutdown
+//     lifecycle are per clone; closing one client does not close others.
+//   - A login is performed when creds carries no ApiKey.
+//
+// Example:
+//
+//	second, err := client.Clone(nobitex.Credentials{ApiKey: os.Getenv("NOBITEX_KEY_2")})
+func (c *Client) Clone(creds Credentials) (*Client, error) {
+	remember := creds.Remember
+	if remember == "" {
+		remember = c.Remember
+	}
+	userAgent := creds.UserAgent
+	if userAgent == "" {
+		userAgent = c.UserAgent
+	}
+	autoRefresh := c.AutoRefresh
+	if creds.AutoRefresh != nil {
+		autoRefresh = *creds.AutoRefresh
+	}
+
+	err := ClientOptions{
+		Remember:    remember,
+		UserAgent:   userAgent,
+		AutoRefresh: autoRefresh,
+		Username:    creds.Username,
+		Password:    creds.Password,
+		OtpSecret:   creds.OtpSecret,
+		OtpCode:     creds.OtpCode,
+		ApiKey:      creds.ApiKey,
+	}.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	clone := &Client{
+		HttpClient:  c.HttpClient,
+		BaseUrl:     c.BaseUrl,
+		Remember:    remember,
+		UserAgent:   userAgent,
+		AutoRefresh: autoRefresh,
+		AutoAuth:    c.AutoAuth,
+
+		caches:      c.shared(),
+		deduplicate: c.deduplicate,
+		metrics:     c.metrics,
+		debug:       c.debug,
+		debugWriter: c.debugWriter,
+		retry:       c.retry,
+		limiter:     c.limiter,
+		rateLimits:  c.rateLimits,
+		maxBodySize: c.maxBodySize,
+		onPanic:     c.onPanic,
+
+		strictStatus: c.strictStatus,
+		reporter:     c.reporter,
+		auditor:      c.auditor,
+
+		slowThreshold: c.slowThreshold,
+		timeout:       c.timeout,
+		readOnly:      c.readOnly,
+		risk:          c.risk,
+		riskState:     c.riskState,
+	}
+
+	c.failover.mu.Lock()
+	clone.failover.mirrors = c.failover.mirrors
+	clone.failover.cooldown = c.failover.cooldown
+	c.failover.mu.Unlock()
+
+	clone.maintenance.interval = c.maintenance.interval
+	clone.events.onPanic = c.onPanic
+
+	if err := clone.login(creds); err != nil {
+		return nil, err
+	}
+	return clone, nil
+}
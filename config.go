@@ -0,0 +1,262 @@
+package nobitex
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	t "github.com/darhelm/go-nobitex/types"
+	"golang.org/x/sync/singleflight"
+)
+
+// DefaultConfigTTL is how long a cached /options payload is served by
+// Client.Config before it is fetched again, unless overridden through
+// ClientOptions.ConfigTTL.
+const DefaultConfigTTL = time.Hour
+
+// configCache holds the most recently fetched exchange configuration.
+// mu guards the fields only; fetches run outside it through flight.
+type configCache struct {
+	mu        sync.Mutex
+	ttl       time.Duration
+	config    *t.Config
+	fetchedAt time.Time
+	flight    singleflight.Group
+}
+
+// Config returns the Nobitex exchange configuration, serving it from an
+// in-memory cache while it is fresh.
+//
+// Parameters:
+//   - ctx: Context used for the underlying request when a fetch is needed.
+//
+// Returns:
+//   - *t.Config shared by all callers; it MUST be treated as read-only.
+//   - An error if the cache is stale or empty and GetNobitexConfig fails.
+//
+// Behavior:
+//   - The first call fetches /v2/options; later calls within the TTL
+//     (ClientOptions.ConfigTTL, default DefaultConfigTTL) perform no I/O.
+//   - Concurrent callers hitting a stale cache, including those of
+//     clones, share a single fetch. Each caller stops waiting when its
+//     own ctx is done; the fetch itself is bounded by the client timeout.
+//   - Use StartConfigRefresh to keep the cache warm in the background so
+//     precision-aware order formatting never waits on the network.
+//
+// Example:
+//
+//	cfg, err := client.Config(ctx)
+//	if err != nil {
+//	    return err
+//	}
+//	fmt.Println(cfg.Nobitex.AmountPrecisions["btc"])
+func (c *Client) Config(ctx context.Context) (*t.Config, error) {
+	cache := &c.shared().config
+
+	cache.mu.Lock()
+	if cache.config != nil && time.Since(cache.fetchedAt) < c.configTTL() {
+		config := cache.config
+		cache.mu.Unlock()
+		return config, nil
+	}
+	cache.mu.Unlock()
+
+	ch := cache.flight.DoChan("config", func() (interface{}, error) {
+		// Detached from the first caller, so its cancellation does not
+		// fail the fetch for the others
+		ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), c.flightTimeout())
+		defer cancel()
+
+		config, err := c.fetchConfig(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		cache.mu.Lock()
+		cache.config = config
+		cache.fetchedAt = time.Now()
+		cache.mu.Unlock()
+		return config, nil
+	})
+
+	select {
+	case <-ctx.Done():
+		return nil, &RequestError{
+			GoNobitexError: GoNobitexError{
+				Message: "failed to fetch configuration",
+				Err:     ctx.Err(),
+			},
+			Operation: "sending request",
+		}
+	case res := <-ch:
+		if res.Err != nil {
+			return nil, res.Err
+		}
+		return res.Val.(*t.Config), nil
+	}
+}
+
+// InvalidateConfig drops the cached configuration so the next Config call
+// fetches a fresh copy.
+func (c *Client) InvalidateConfig() {
+	c.shared().config.mu.Lock()
+	defer c.shared().config.mu.Unlock()
+
+	c.shared().config.config = nil
+}
+
+// StartConfigRefresh launches a background goroutine that refreshes the
+// cached configuration once per TTL until ctx is canceled or the client
+// is closed.
+//
+// Behavior:
+//   - The first refresh happens immediately.
+//   - Failed refreshes keep the previous cached value; the next tick retries.
+//   - Calling it more than once starts independent refresh loops.
+//
+// Example:
+//
+//	ctx, cancel := context.WithCancel(context.Background())
+//	defer cancel()
+//	client.StartConfigRefresh(ctx)
+func (c *Client) StartConfigRefresh(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(c.configTTL())
+		defer ticker.Stop()
+
+		for {
+			if config, err := c.fetchConfig(ctx); err == nil {
+				c.shared().config.mu.Lock()
+				c.shared().config.config = config
+				c.shared().config.fetchedAt = time.Now()
+				c.shared().config.mu.Unlock()
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-c.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// fetchConfig performs the uncached /v2/options request.
+func (c *Client) fetchConfig(ctx context.Context) (*t.Config, error) {
+	var config *t.Config
+	err := c.ApiRequest("GET", "/options", "v2", false, false, nil, &config, WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+// configTTL returns the effective cache lifetime for Config.
+func (c *Client) configTTL() time.Duration {
+	if c.shared().config.ttl > 0 {
+		return c.shared().config.ttl
+	}
+	return DefaultConfigTTL
+}
+
+// Feature names reported by the /options payload that can be passed to
+// Client.Supports.
+const (
+	FeatureConvert = "convert"
+	FeatureStaking = "staking"
+	FeatureLoan    = "loan"
+	FeatureMargin  = "margin"
+)
+
+// Supports reports whether the exchange currently advertises feature, so
+// code can branch on capabilities instead of failing at call time.
+//
+// Parameters:
+//   - ctx: Used when the cached configuration must be fetched.
+//   - feature: A flag name such as FeatureConvert.
+//
+// Returns:
+//   - true when feature is set in Config.Features or listed in
+//     Nobitex.EnabledFeatures.
+//   - An error if the configuration cannot be fetched.
+//
+// Example:
+//
+//	if ok, _ := client.Supports(ctx, nobitex.FeatureConvert); ok {
+//	    quote, err := client.GetConvertQuote(params)
+//	    ...
+//	}
+func (c *Client) Supports(ctx context.Context, feature string) (bool, error) {
+	config, err := c.Config(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	if enabled, ok := config.Features[feature]; ok {
+		return enabled, nil
+	}
+	return slices.Contains(config.Nobitex.EnabledFeatures, feature), nil
+}
+
+// SupportsMarket reports whether symbol (e.g. "BTCIRT") is open for
+// trading. Symbols are compared in their NormalizeSymbol form, so "btcrls"
+// matches "BTCIRT". When the payload carries no market list it falls back
+// to checking that the symbol's base currency is active.
+//
+// Returns:
+//   - An error if symbol is invalid or the configuration cannot be
+//     fetched.
+func (c *Client) SupportsMarket(ctx context.Context, symbol string) (bool, error) {
+	symbol, err := NormalizeSymbol(symbol)
+	if err != nil {
+		return false, err
+	}
+	config, err := c.Config(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	if len(config.Nobitex.ActiveMarkets) > 0 {
+		return slices.ContainsFunc(config.Nobitex.ActiveMarkets, func(market string) bool {
+			normalized, err := NormalizeSymbol(market)
+			return err == nil && normalized == symbol
+		}), nil
+	}
+
+	base, _, err := splitMarketSymbol(symbol)
+	if err != nil {
+		return false, err
+	}
+	return slices.ContainsFunc(config.Nobitex.ActiveCurrencies, func(currency string) bool {
+		return strings.EqualFold(currency, base)
+	}), nil
+}
+
+// MinOrderValue returns the minimum order value for markets quoted in
+// quoteCurrency (e.g. "rls" or "usdt") and whether one is published.
+func (c *Client) MinOrderValue(ctx context.Context, quoteCurrency string) (string, bool, error) {
+	config, err := c.Config(ctx)
+	if err != nil {
+		return "", false, err
+	}
+
+	value, ok := config.Nobitex.MinOrders[strings.ToLower(quoteCurrency)]
+	return value, ok, nil
+}
+
+// TradingFee returns the default maker/taker fees for markets quoted in
+// quoteCurrency and whether a schedule is published. These are the base
+// tier's rates; accounts with a volume discount pay less.
+func (c *Client) TradingFee(ctx context.Context, quoteCurrency string) (t.TradingFee, bool, error) {
+	config, err := c.Config(ctx)
+	if err != nil {
+		return t.TradingFee{}, false, err
+	}
+
+	fee, ok := config.Nobitex.TradingFees[strings.ToLower(quoteCurrency)]
+	return fee, ok, nil
+}
@@ -0,0 +1,92 @@
+package nobitex
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestSupportsMarketMatchesExactly(t *testing.T) {
+	options := map[string]string{
+		"markets":    `{"status":"ok","nobitex":{"activeMarkets":["BTCIRT","BTCUSDT"]}}`,
+		"currencies": `{"status":"ok","nobitex":{"activeCurrencies":["btc","rls","usdt"]}}`,
+	}
+
+	for name, body := range options {
+		c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(body))
+		}), ClientOptions{})
+
+		tests := []struct {
+			symbol string
+			want   bool
+		}{
+			{"BTCIRT", true},
+			{"btcrls", true},
+			{"BTCUSDT", true},
+			{"BTCBIRT", false},
+			{"BTCBUSDT", false},
+		}
+		for _, tt := range tests {
+			got, err := c.SupportsMarket(context.Background(), tt.symbol)
+			if err != nil {
+				t.Fatalf("%s: SupportsMarket(%q): %v", name, tt.symbol, err)
+			}
+			if got != tt.want {
+				t.Errorf("%s: SupportsMarket(%q) = %v, want %v", name, tt.symbol, got, tt.want)
+			}
+		}
+	}
+
+	c := newTestClient(t, http.NotFoundHandler(), ClientOptions{})
+	if _, err := c.SupportsMarket(context.Background(), "btc-irt"); err == nil {
+		t.Error("SupportsMarket accepted a separated symbol")
+	}
+}
+
+func TestConfigWaitersHonorTheirContext(t *testing.T) {
+	var hits atomic.Int32
+	release := make(chan struct{})
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+		<-release
+		_, _ = w.Write([]byte(`{"status":"ok","nobitex":{"activeMarkets":["BTCIRT"]}}`))
+	}), ClientOptions{})
+	clone, err := c.Clone(Credentials{ApiKey: "other-key"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	first := make(chan error, 1)
+	go func() {
+		_, err := c.Config(context.Background())
+		first <- err
+	}()
+	for hits.Load() == 0 {
+		time.Sleep(time.Millisecond)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	started := time.Now()
+	if _, err := clone.Config(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("err = %v, want context.DeadlineExceeded", err)
+	}
+	if waited := time.Since(started); waited > time.Second {
+		t.Fatalf("waiter blocked for %s past its deadline", waited)
+	}
+
+	close(release)
+	if err := <-first; err != nil {
+		t.Fatal(err)
+	}
+	if _, err := clone.Config(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if got := hits.Load(); got != 1 {
+		t.Fatalf("fetches = %d, want 1", got)
+	}
+}
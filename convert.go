@@ -0,0 +1,80 @@
+package nobitex
+
+import (
+	t "github.com/darhelm/go-nobitex/types"
+)
+
+// GetConvertQuote requests a fixed-rate quote for an instant conversion
+// (Nobitex Xchange), which settles immediately without going through the
+// order book.
+//
+// Endpoint:
+//
+//	POST /exchange/get-quote
+//
+// Parameters:
+//   - params: t.GetConvertQuoteParams
+//     SrcCurrency / DstCurrency
+//     Amount
+//     IsSell (whether Amount is the sold or bought side)
+//
+// Returns:
+//   - *t.ConvertQuoteResponse containing:
+//     Status
+//     Quote → QuoteId, amounts, Rate, ExpiresAt
+//
+// Behavior:
+//   - Requires authentication.
+//   - The quote is only valid until Quote.ExpiresAt; execute it with
+//     ExecuteConvert before then.
+//
+// Example:
+//
+//	quote, err := client.GetConvertQuote(t.GetConvertQuoteParams{
+//	    SrcCurrency: "usdt",
+//	    DstCurrency: "btc",
+//	    Amount:      "100",
+//	    IsSell:      true,
+//	})
+func (c *Client) GetConvertQuote(params t.GetConvertQuoteParams, opts ...RequestOption) (*t.ConvertQuoteResponse, error) {
+	var quote *t.ConvertQuoteResponse
+	err := c.ApiRequest("POST", "/exchange/get-quote", "", true, false, params, &quote, idempotent(opts)...)
+	if err != nil {
+		return nil, err
+	}
+	return quote, nil
+}
+
+// ExecuteConvert executes a quote obtained from GetConvertQuote at its
+// fixed rate.
+//
+// Endpoint:
+//
+//	POST /exchange/create-trade
+//
+// Parameters:
+//   - params: t.ExecuteConvertParams
+//     QuoteId
+//
+// Returns:
+//   - *t.ConvertTradeResponse containing:
+//     Status
+//     Trade → Id, amounts, Rate, Status
+//
+// Behavior:
+//   - Requires authentication.
+//   - Fails with an APIError when the quote has expired or the balance is
+//     insufficient.
+//
+// Example:
+//
+//	quote, _ := client.GetConvertQuote(params)
+//	trade, err := client.ExecuteConvert(t.ExecuteConvertParams{QuoteId: quote.Quote.QuoteId})
+func (c *Client) ExecuteConvert(params t.ExecuteConvertParams, opts ...RequestOption) (*t.ConvertTradeResponse, error) {
+	var trade *t.ConvertTradeResponse
+	err := c.ApiRequest("POST", "/exchange/create-trade", "", true, false, params, &trade, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return trade, nil
+}
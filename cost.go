@@ -0,0 +1,225 @@
+package nobitex
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+
+	t "github.com/darhelm/go-nobitex/types"
+)
+
+// TradeCostOptions configures Client.TradeCost.
+type TradeCostOptions struct {
+	// Taker applies the taker rate to limit orders that are expected to
+	// match immediately. Market and stop-market orders always pay taker.
+	Taker bool
+}
+
+// TradeCost computes the total cost or proceeds, fee and break-even price
+// of an order before it is placed, rounding with the precision data
+// Nobitex publishes in /options so the numbers match the exchange.
+//
+// Parameters:
+//   - ctx: Used when the cached configuration must be fetched.
+//   - params: The order as it would be passed to CreateOrder. Price is
+//     required; for market orders pass the expected fill price (see
+//     analytics.EstimateFill).
+//   - fee: The account's maker/taker rates, in percent. Nobitex discounts
+//     fees by trading volume, so pass the tier shown for the account;
+//     Client.TradingFee returns the public base schedule, which is only
+//     exact for accounts without a discount.
+//   - opts: Maker/taker selection.
+//
+// Returns:
+//   - *t.TradeCost with every amount as an exact decimal string.
+//   - An error if the configuration cannot be fetched or params or fee
+//     cannot be parsed.
+//
+// Example:
+//
+//	cost, err := client.TradeCost(ctx, t.CreateOrderParams{
+//	    Execution: "limit", Type: "buy",
+//	    SrcCurrency: "btc", DstCurrency: "rls",
+//	    Amount: "0.01", Price: "6500000000",
+//	}, t.TradingFee{Maker: "0.25", Taker: "0.25"}, nobitex.TradeCostOptions{})
+//	fmt.Println(cost.Total, cost.Fee, cost.BreakEvenPrice)
+func (c *Client) TradeCost(ctx context.Context, params t.CreateOrderParams, fee t.TradingFee, opts TradeCostOptions) (*t.TradeCost, error) {
+	config, err := c.Config(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	feeRate := fee.Maker
+	if opts.Taker || params.Execution == "market" || params.Execution == "stop_market" {
+		feeRate = fee.Taker
+	}
+
+	market := MarketSymbol(params.SrcCurrency, params.DstCurrency)
+	amountPrecision := marketAmountStep(&config.Nobitex, market, params.SrcCurrency)
+	pricePrecision := marketPriceStep(&config.Nobitex, market, params.DstCurrency)
+
+	return CalculateTradeCost(params, feeRate, amountPrecision.Value, pricePrecision.Value)
+}
+
+// CalculateTradeCost is the offline core of TradeCost.
+//
+// Parameters:
+//   - params: Order side, currencies, Amount and Price.
+//   - feeRate: Fee in percent, e.g. "0.25".
+//   - amountPrecision / pricePrecision: Step sizes such as "0.000001" or
+//     "10". Empty means no rounding.
+//
+// Behavior:
+//   - Amount is rounded down and Price to the nearest step.
+//   - Buys pay the fee in the base currency, sells in the quote currency.
+func CalculateTradeCost(params t.CreateOrderParams, feeRate string, amountPrecision string, pricePrecision string) (*t.TradeCost, error) {
+	if params.Type != "buy" && params.Type != "sell" {
+		return nil, &GoNobitexError{Message: fmt.Sprintf("invalid order type %q", params.Type)}
+	}
+
+	amount, err := parseDecimal("amount", params.Amount)
+	if err != nil {
+		return nil, err
+	}
+	price, err := parseDecimal("price", params.Price)
+	if err != nil {
+		return nil, err
+	}
+	rate, err := parseDecimal("fee rate", feeRate)
+	if err != nil {
+		return nil, err
+	}
+	rate.Quo(rate, big.NewRat(100, 1))
+
+	amountStep, err := parseStep(amountPrecision)
+	if err != nil {
+		return nil, err
+	}
+	priceStep, err := parseStep(pricePrecision)
+	if err != nil {
+		return nil, err
+	}
+	amount = roundToStep(amount, amountStep, false)
+	price = roundToStep(price, priceStep, true)
+
+	gross := new(big.Rat).Mul(amount, price)
+	keep := new(big.Rat).Sub(big.NewRat(1, 1), rate)
+
+	cost := &t.TradeCost{
+		Market:  MarketSymbol(params.SrcCurrency, params.DstCurrency),
+		Type:    params.Type,
+		Amount:  FormatDecimal(amount),
+		Price:   FormatDecimal(price),
+		Gross:   FormatDecimal(gross),
+		FeeRate: FormatDecimal(new(big.Rat).Mul(rate, big.NewRat(100, 1))),
+		Total:   FormatDecimal(gross),
+	}
+
+	// Reversing the trade pays the fee twice: once now, once on the way back
+	roundTrip := new(big.Rat).Mul(keep, keep)
+
+	if params.Type == "buy" {
+		cost.FeeCurrency = strings.ToLower(params.SrcCurrency)
+		cost.Fee = FormatDecimal(new(big.Rat).Mul(amount, rate))
+		cost.Net = FormatDecimal(new(big.Rat).Mul(amount, keep))
+		if roundTrip.Sign() > 0 {
+			cost.BreakEvenPrice = FormatDecimal(new(big.Rat).Quo(price, roundTrip))
+		}
+	} else {
+		cost.FeeCurrency = strings.ToLower(params.DstCurrency)
+		cost.Fee = FormatDecimal(new(big.Rat).Mul(gross, rate))
+		net := new(big.Rat).Mul(gross, keep)
+		cost.Net = FormatDecimal(net)
+		cost.Total = cost.Net
+		cost.BreakEvenPrice = FormatDecimal(new(big.Rat).Mul(price, roundTrip))
+	}
+
+	return cost, nil
+}
+
+// MarketSymbol builds the Nobitex market symbol of a currency pair, e.g.
+// ("btc", "rls") → "BTCIRT". Rial markets are named after the toman.
+func MarketSymbol(src, dst string) string {
+	dst = strings.ToUpper(dst)
+	if dst == "RLS" {
+		dst = "IRT"
+	}
+	return strings.ToUpper(src) + dst
+}
+
+// marketAmountStep returns the amount step Nobitex publishes for market, or
+// for currency when the market has none. The zero Precision (no
+// rounding) is returned when neither has one.
+func marketAmountStep(n *t.Nobitex, market string, currency string) t.Precision {
+	if step, ok := n.StepSize(market); ok {
+		return step
+	}
+	step, _ := n.StepSize(currency)
+	return step
+}
+
+// marketPriceStep returns the price step Nobitex publishes for market, or for
+// the quote currency when the market has none.
+func marketPriceStep(n *t.Nobitex, market string, quote string) t.Precision {
+	if step, ok := n.TickSize(market); ok {
+		return step
+	}
+	step, _ := n.TickSize(quote)
+	return step
+}
+
+// parseDecimal parses a required decimal string.
+func parseDecimal(name string, value string) (*big.Rat, error) {
+	r, ok := new(big.Rat).SetString(value)
+	if !ok {
+		return nil, &GoNobitexError{Message: fmt.Sprintf("invalid %s %q", name, value)}
+	}
+	return r, nil
+}
+
+// parseStep parses an optional precision step; empty yields nil.
+func parseStep(value string) (*big.Rat, error) {
+	if value == "" {
+		return nil, nil
+	}
+	step, err := parseDecimal("precision", value)
+	if err != nil || step.Sign() <= 0 {
+		return nil, &GoNobitexError{Message: fmt.Sprintf("invalid precision %q", value)}
+	}
+	return step, nil
+}
+
+// FloorToStep rounds x down to a multiple of step, e.g. an amount to a
+// market's StepSize. A nil step returns x.
+func FloorToStep(x *big.Rat, step *big.Rat) *big.Rat {
+	return roundToStep(x, step, false)
+}
+
+// roundToStep rounds x to a multiple of step, to the nearest multiple when
+// nearest is set and towards zero otherwise. A nil step returns x.
+func roundToStep(x *big.Rat, step *big.Rat, nearest bool) *big.Rat {
+	if step == nil {
+		return x
+	}
+
+	q := new(big.Rat).Quo(x, step)
+	if nearest {
+		q.Add(q, big.NewRat(1, 2))
+	}
+	n := new(big.Int).Quo(q.Num(), q.Denom())
+	return new(big.Rat).Mul(new(big.Rat).SetInt(n), step)
+}
+
+// FormatDecimal renders r the way Nobitex expects decimal strings: up to
+// 18 decimals, trailing zeros trimmed.
+func FormatDecimal(r *big.Rat) string {
+	s := r.FloatString(18)
+	if strings.Contains(s, ".") {
+		s = strings.TrimRight(strings.TrimRight(s, "0"), ".")
+	}
+	if s == "-0" {
+		s = "0"
+	}
+	return s
+}
@@ -0,0 +1,61 @@
+package nobitex
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/darhelm/go-nobitex/types"
+)
+
+func TestMarketAmountStep(t *testing.T) {
+	var n types.Nobitex
+	payload := `{"amountPrecisions":{"BTCIRT":"0.000001","btc":"0.0001","eth":"0.001"}}`
+	if err := json.Unmarshal([]byte(payload), &n); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		market, currency, want string
+	}{
+		{"BTCIRT", "btc", "0.000001"},
+		{"BTCUSDT", "btc", "0.0001"},
+		{"ETHUSDT", "ETH", "0.001"},
+		{"DOGEUSDT", "doge", ""},
+	}
+	for _, tt := range tests {
+		if got := marketAmountStep(&n, tt.market, tt.currency).Value; got != tt.want {
+			t.Errorf("marketAmountStep(%s, %s) = %q, want %q", tt.market, tt.currency, got, tt.want)
+		}
+	}
+}
+
+func TestFloorToStep(t *testing.T) {
+	tests := []struct {
+		x, step, want string
+	}{
+		{"1.23456789", "0.0001", "1.2345"},
+		{"155", "10", "150"},
+		{"0.00009", "0.0001", "0"},
+		{"1.5", "", "1.5"},
+	}
+	for _, tt := range tests {
+		x, _ := new(big.Rat).SetString(tt.x)
+		var step *big.Rat
+		if tt.step != "" {
+			step, _ = new(big.Rat).SetString(tt.step)
+		}
+		if got := FormatDecimal(FloorToStep(x, step)); got != tt.want {
+			t.Errorf("FloorToStep(%s, %q) = %s, want %s", tt.x, tt.step, got, tt.want)
+		}
+	}
+}
+
+func TestMarketSymbol(t *testing.T) {
+	if got := MarketSymbol("btc", "rls"); got != "BTCIRT" {
+		t.Errorf("MarketSymbol(btc, rls) = %s, want BTCIRT", got)
+	}
+	if got := MarketSymbol("eth", "usdt"); got != "ETHUSDT" {
+		t.Errorf("MarketSymbol(eth, usdt) = %s, want ETHUSDT", got)
+	}
+}
@@ -0,0 +1,106 @@
+package nobitex
+
+import (
+	"expvar"
+	"sync/atomic"
+)
+
+// Counter names reported to a StatsReporter and published by
+// PublishExpvar.
+const (
+	CounterRequests      = "requests"
+	CounterErrors        = "errors"
+	CounterRetries       = "retries"
+	CounterAuthRefreshes = "auth_refreshes"
+	CounterFailovers     = "failovers"
+	CounterMaintenance   = "maintenance_entries"
+)
+
+// StatsReporter receives counter increments as they happen, for
+// lightweight metrics backends (StatsD, logs, custom dashboards). Count
+// is called from request goroutines and must be safe for concurrent use.
+type StatsReporter interface {
+	Count(name string, delta int64)
+}
+
+// StatsReporterFunc adapts a function to StatsReporter.
+type StatsReporterFunc func(name string, delta int64)
+
+// Count calls f.
+func (f StatsReporterFunc) Count(name string, delta int64) {
+	f(name, delta)
+}
+
+// Counters is a snapshot of the client's lifetime counters.
+type Counters struct {
+	// Requests counts HTTP exchanges with the API, retries included.
+	Requests uint64
+
+	// Errors counts exchanges that failed, including API errors.
+	Errors uint64
+
+	// Retries counts repeated attempts made by the retry policy.
+	Retries uint64
+
+	// AuthRefreshes counts API key renewals performed by AutoRefresh.
+	AuthRefreshes uint64
+
+	// Failovers counts switches away from an unreachable base URL.
+	Failovers uint64
+
+	// MaintenanceEntries counts transitions into maintenance mode.
+	MaintenanceEntries uint64
+}
+
+// counters holds the live values behind Counters.
+type counters struct {
+	requests      atomic.Uint64
+	errors        atomic.Uint64
+	retries       atomic.Uint64
+	authRefreshes atomic.Uint64
+	failovers     atomic.Uint64
+	maintenance   atomic.Uint64
+}
+
+// count increments counter and forwards the increment to the reporter.
+func (c *Client) count(name string, counter *atomic.Uint64) {
+	counter.Add(1)
+	if c.reporter != nil {
+		safeHook("stats reporter", c.onPanic, func() {
+			c.reporter.Count(name, 1)
+		})
+	}
+}
+
+// Counters returns the counters accumulated since the client was created.
+func (c *Client) Counters() Counters {
+	return Counters{
+		Requests:           c.counters.requests.Load(),
+		Errors:             c.counters.errors.Load(),
+		Retries:            c.counters.retries.Load(),
+		AuthRefreshes:      c.counters.authRefreshes.Load(),
+		Failovers:          c.counters.failovers.Load(),
+		MaintenanceEntries: c.counters.maintenance.Load(),
+	}
+}
+
+// PublishExpvar exposes the client's counters and Stats under name in the
+// expvar registry, served as JSON on /debug/vars by the default HTTP mux.
+// Values are computed on every read. Publishing a name that is already
+// registered is a no-op, so each client needs its own name.
+//
+// Example:
+//
+//	client.PublishExpvar("nobitex")
+//	go http.ListenAndServe("localhost:6060", nil) // GET /debug/vars
+func (c *Client) PublishExpvar(name string) {
+	if expvar.Get(name) != nil {
+		return
+	}
+	expvar.Publish(name, expvar.Func(func() any {
+		return struct {
+			Counters Counters
+			Stats    Stats
+		}{c.Counters(), c.Stats()}
+	}))
+}
@@ -0,0 +1,140 @@
+package nobitex
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// CurlTransport is an http.RoundTripper that prints every request as an
+// equivalent curl command, with the same secret redaction as debug
+// dumps: Authorization and X-TOTP headers, passwords, keys and OTP codes
+// are replaced by "***". The commands can be shared with Nobitex support
+// to reproduce a failing call without leaking credentials.
+//
+// Example:
+//
+//	client, err := nobitex.NewClient(nobitex.ClientOptions{
+//	    HttpClient: &http.Client{
+//	        Transport: &nobitex.CurlTransport{},
+//	        Timeout:   30 * time.Second,
+//	    },
+//	    ...
+//	})
+//
+// Output:
+//
+//	curl -X POST 'https://apiv2.nobitex.ir/market/orders/add' \
+//	  -H 'Authorization: ***' -H 'Content-Type: application/json' \
+//	  --data-raw '{"type":"buy",...}'
+//	# => 200 OK in 84ms
+type CurlTransport struct {
+	// Next performs the requests. Defaults to http.DefaultTransport.
+	Next http.RoundTripper
+
+	// Writer receives the commands. Defaults to os.Stderr.
+	Writer io.Writer
+
+	// HideResponses suppresses the "# => status" line written after each
+	// response.
+	HideResponses bool
+
+	mu sync.Mutex
+}
+
+// RoundTrip prints req as a curl command and forwards it to Next.
+func (t *CurlTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, err := peekBody(req)
+	if err != nil {
+		return nil, err
+	}
+	t.write(CurlCommand(req, body))
+
+	next := t.Next
+	if next == nil {
+		next = http.DefaultTransport
+	}
+
+	started := time.Now()
+	resp, err := next.RoundTrip(req)
+	if !t.HideResponses {
+		elapsed := time.Since(started).Round(time.Millisecond)
+		if err != nil {
+			t.write(fmt.Sprintf("# => error after %v: %v", elapsed, err))
+		} else {
+			t.write(fmt.Sprintf("# => %s in %v", resp.Status, elapsed))
+		}
+	}
+	return resp, err
+}
+
+// write prints one entry.
+func (t *CurlTransport) write(entry string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	writer := t.Writer
+	if writer == nil {
+		writer = os.Stderr
+	}
+	_, _ = fmt.Fprintln(writer, entry)
+}
+
+// CurlCommand renders req with the given body as a redacted curl command.
+func CurlCommand(req *http.Request, body []byte) string {
+	header := req.Header.Clone()
+	maskHeaders(header)
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "curl -X %s %s", req.Method, shellQuote(maskSecrets(req.URL.String())))
+
+	keys := make([]string, 0, len(header))
+	for key := range header {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		for _, value := range header[key] {
+			fmt.Fprintf(&b, " \\\n  -H %s", shellQuote(key+": "+value))
+		}
+	}
+
+	if len(body) > 0 {
+		fmt.Fprintf(&b, " \\\n  --data-raw %s", shellQuote(maskSecrets(string(body))))
+	}
+	return b.String()
+}
+
+// peekBody reads the request body and replaces it so it can still be
+// sent.
+func peekBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err == nil {
+			defer body.Close()
+			return io.ReadAll(body)
+		}
+	}
+
+	data, err := io.ReadAll(req.Body)
+	_ = req.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	req.Body = io.NopCloser(bytes.NewReader(data))
+	return data, nil
+}
+
+// shellQuote quotes s for a POSIX shell.
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
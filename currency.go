@@ -0,0 +1,67 @@
+package nobitex
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	t "github.com/darhelm/go-nobitex/types"
+)
+
+// Currencies returns the deposit and withdrawal metadata of every
+// currency: networks, network fees, withdrawal limits and required
+// confirmations.
+//
+// Behavior:
+//   - Served from the cached /v2/options payload (see Config); the slice
+//     is shared and MUST be treated as read-only.
+//
+// Example:
+//
+//	currencies, err := client.Currencies(ctx)
+//	if err != nil {
+//	    return err
+//	}
+//	for _, c := range currencies {
+//	    fmt.Println(c.Coin, len(c.Networks))
+//	}
+func (c *Client) Currencies(ctx context.Context) ([]t.CurrencyInfo, error) {
+	config, err := c.Config(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return config.Coins, nil
+}
+
+// Currency returns the metadata of one currency.
+//
+// Parameters:
+//   - ctx: Used when the cached configuration must be fetched.
+//   - currency: Asset symbol such as "usdt"; case-insensitive.
+//
+// Returns:
+//   - *t.CurrencyInfo; use Network or DefaultNetwork for per-network
+//     fees, limits and confirmations.
+//   - A GoNobitexError if Nobitex publishes no metadata for currency.
+//
+// Example:
+//
+//	usdt, err := client.Currency(ctx, "usdt")
+//	if err != nil {
+//	    return err
+//	}
+//	trc20, _ := usdt.Network("TRX")
+//	fmt.Println(trc20.WithdrawFee, trc20.WithdrawMin, trc20.MinConfirm)
+func (c *Client) Currency(ctx context.Context, currency string) (*t.CurrencyInfo, error) {
+	currencies, err := c.Currencies(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range currencies {
+		if strings.EqualFold(currencies[i].Coin, currency) {
+			return &currencies[i], nil
+		}
+	}
+	return nil, &GoNobitexError{Message: fmt.Sprintf("no metadata published for currency %q", currency)}
+}
@@ -0,0 +1,120 @@
+package nobitex
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Cursor endpoint identifiers stored in Cursor.Endpoint.
+const (
+	CursorTrades      = "trades"
+	CursorOrders      = "orders"
+	CursorDeposits    = "deposits"
+	CursorWithdrawals = "withdrawals"
+)
+
+// Cursor is a pagination position shared by every paginated endpoint. It
+// captures whichever of FromId, Page and Time the endpoint paginates by,
+// and serializes to an opaque string suitable for databases, files or
+// URLs, so applications can persist and resume positions uniformly.
+//
+// Example:
+//
+//	token := nobitex.Cursor{Endpoint: nobitex.CursorTrades, FromId: 1234}.Encode()
+//	// ... persist token, restart ...
+//	cursor, err := nobitex.DecodeCursor(token)
+type Cursor struct {
+	// Endpoint names the listing the cursor belongs to (CursorTrades, ...).
+	Endpoint string `json:"e,omitempty"`
+
+	// FromId is the next id to request on id-paginated listings.
+	FromId int64 `json:"i,omitempty"`
+
+	// Page is the next 1-based page on page-paginated listings.
+	Page int `json:"p,omitempty"`
+
+	// Time is the newest timestamp already processed, for time-bounded
+	// listings. Stored with second precision.
+	Time time.Time `json:"-"`
+}
+
+// cursorWire is the serialized form of Cursor.
+type cursorWire struct {
+	Cursor
+	Unix int64 `json:"t,omitempty"`
+}
+
+// IsZero reports whether the cursor points at the start of a listing.
+func (c Cursor) IsZero() bool {
+	return c.FromId == 0 && c.Page == 0 && c.Time.IsZero()
+}
+
+// Encode serializes the cursor into an opaque, URL-safe token. The zero
+// cursor encodes to the empty string.
+func (c Cursor) Encode() string {
+	if c.IsZero() && c.Endpoint == "" {
+		return ""
+	}
+
+	wire := cursorWire{Cursor: c}
+	if !c.Time.IsZero() {
+		wire.Unix = c.Time.Unix()
+	}
+
+	raw, _ := json.Marshal(wire)
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+// String returns the encoded cursor.
+func (c Cursor) String() string {
+	return c.Encode()
+}
+
+// DecodeCursor parses a token produced by Cursor.Encode. The empty string
+// decodes to the zero cursor.
+func DecodeCursor(token string) (Cursor, error) {
+	if token == "" {
+		return Cursor{}, nil
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return Cursor{}, &GoNobitexError{
+			Message: "invalid cursor token",
+			Err:     err,
+		}
+	}
+
+	var wire cursorWire
+	if err := json.Unmarshal(raw, &wire); err != nil {
+		return Cursor{}, &GoNobitexError{
+			Message: "invalid cursor token",
+			Err:     err,
+		}
+	}
+
+	cursor := wire.Cursor
+	if wire.Unix != 0 {
+		cursor.Time = time.Unix(wire.Unix, 0)
+	}
+	return cursor, nil
+}
+
+// decodeCursorFor decodes token and verifies it belongs to endpoint.
+func decodeCursorFor(token string, endpoint string) (Cursor, error) {
+	cursor, err := DecodeCursor(token)
+	if err != nil {
+		return Cursor{}, err
+	}
+
+	if cursor.Endpoint != "" && cursor.Endpoint != endpoint {
+		return Cursor{}, &GoNobitexError{
+			Message: fmt.Sprintf("cursor belongs to %q, not %q", cursor.Endpoint, endpoint),
+		}
+	}
+
+	cursor.Endpoint = endpoint
+	return cursor, nil
+}
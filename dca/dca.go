@@ -0,0 +1,360 @@
+// Package dca places recurring purchases (dollar-cost averaging) on a
+// schedule, with a fixed budget per run, balance checks and a journal of
+// every execution.
+package dca
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+	"time"
+
+	nobitex "github.com/darhelm/go-nobitex"
+	"github.com/darhelm/go-nobitex/notify"
+	"github.com/darhelm/go-nobitex/store"
+	t "github.com/darhelm/go-nobitex/types"
+)
+
+// Exchange is the subset of *nobitex.Client used by a Scheduler.
+type Exchange interface {
+	GetWallets(params t.GetWalletParams, opts ...nobitex.RequestOption) (*t.Wallets, error)
+	GetOrderBook(symbol string, opts ...nobitex.RequestOption) (*t.OrderBook, error)
+	CreateOrder(params t.CreateOrderParams, opts ...nobitex.RequestOption) (*t.OrderStatus, error)
+}
+
+// Plan describes one recurring purchase.
+type Plan struct {
+	// Name identifies the plan in the journal.
+	Name string
+
+	// SrcCurrency is the asset bought, e.g. "btc".
+	SrcCurrency string
+
+	// DstCurrency is the currency spent, e.g. "rls" or "usdt".
+	DstCurrency string
+
+	// Budget is the quote amount spent per run, as a decimal string.
+	Budget string
+
+	// Execution is "market" (default) or "limit".
+	Execution string
+
+	// LimitOffset places limit orders this fraction below the best ask
+	// (0.001 = 0.1%). Ignored for market orders.
+	LimitOffset float64
+
+	// AmountPrecision is the step the base amount is rounded down to,
+	// e.g. "0.000001". Empty keeps 8 decimals.
+	AmountPrecision string
+
+	// PricePrecision is the step limit prices are rounded down to, e.g.
+	// "10". Empty keeps the best ask's precision.
+	PricePrecision string
+
+	// Schedule decides when the plan runs.
+	Schedule Schedule
+}
+
+// Run statuses recorded in Execution.Status.
+const (
+	StatusPlaced  = "placed"
+	StatusSkipped = "skipped"
+	StatusFailed  = "failed"
+)
+
+// Execution is one journal entry.
+type Execution struct {
+	// Plan is Plan.Name.
+	Plan string
+
+	// At is when the run started.
+	At time.Time
+
+	// Slot is the scheduled time the run was for. ClientOrderId is
+	// derived from it.
+	Slot time.Time
+
+	// Status is StatusPlaced, StatusSkipped or StatusFailed.
+	Status string
+
+	// Reason explains skipped and failed runs.
+	Reason string
+
+	// OrderId is the id of the placed order.
+	OrderId int
+
+	// ClientOrderId is the idempotency key sent with the order.
+	ClientOrderId string
+
+	// Amount and Price are the order's base amount and reference price.
+	Amount string
+	Price  string
+
+	// Budget is the quote amount the run was allowed to spend.
+	Budget string
+}
+
+// Journal stores executions.
+type Journal interface {
+	Record(ctx context.Context, execution Execution) error
+}
+
+// MemoryJournal is an in-memory Journal, mainly useful for tests and
+// short-lived processes.
+type MemoryJournal struct {
+	mu         sync.Mutex
+	executions []Execution
+}
+
+// Record appends execution.
+func (j *MemoryJournal) Record(_ context.Context, execution Execution) error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	j.executions = append(j.executions, execution)
+	return nil
+}
+
+// Executions returns a copy of the recorded executions.
+func (j *MemoryJournal) Executions() []Execution {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	return append([]Execution(nil), j.executions...)
+}
+
+// StoreJournal is a Journal persisted in a store.Store, keyed by plan
+// name and run time.
+type StoreJournal struct {
+	Store store.Store
+}
+
+// Record persists execution.
+func (j StoreJournal) Record(ctx context.Context, execution Execution) error {
+	key := fmt.Sprintf("%s/%020d", execution.Plan, execution.At.UnixNano())
+	return store.PutJSON(ctx, j.Store, store.BucketJournal, key, execution)
+}
+
+// Executions returns the recorded executions of plan, oldest first.
+// An empty plan returns every execution, grouped by plan.
+func (j StoreJournal) Executions(ctx context.Context, plan string) ([]Execution, error) {
+	keys, err := j.Store.Keys(ctx, store.BucketJournal)
+	if err != nil {
+		return nil, err
+	}
+
+	var executions []Execution
+	for _, key := range keys {
+		if plan != "" && !strings.HasPrefix(key, plan+"/") {
+			continue
+		}
+		var execution Execution
+		if err := store.GetJSON(ctx, j.Store, store.BucketJournal, key, &execution); err != nil {
+			return nil, err
+		}
+		executions = append(executions, execution)
+	}
+	return executions, nil
+}
+
+// Scheduler runs plans on their schedules.
+//
+// Example:
+//
+//	journal := &dca.MemoryJournal{}
+//	s := &dca.Scheduler{Exchange: client, Journal: journal}
+//	s.Add(dca.Plan{
+//	    Name: "weekly-btc", SrcCurrency: "btc", DstCurrency: "rls",
+//	    Budget: "50000000", AmountPrecision: "0.000001",
+//	    Schedule: dca.WeeklyAt(time.Saturday, 9, 0, time.Local),
+//	})
+//	err := s.Run(ctx) // blocks until ctx is canceled
+type Scheduler struct {
+	// Exchange places the orders. *nobitex.Client implements it.
+	Exchange Exchange
+
+	// Journal records every run. Required.
+	Journal Journal
+
+	// Notifier, if set, receives a "dca.<status>" event for every run.
+	Notifier notify.Notifier
+
+	// OnError, if set, receives journal and notification failures.
+	OnError func(err error)
+
+	plans []Plan
+}
+
+// Add registers a plan. It must be called before Run.
+func (s *Scheduler) Add(plan Plan) {
+	s.plans = append(s.plans, plan)
+}
+
+// Run executes every plan at its scheduled times until ctx is canceled,
+// then returns ctx.Err(). Runs missed while the process was down are not
+// caught up.
+func (s *Scheduler) Run(ctx context.Context) error {
+	if s.Journal == nil {
+		return fmt.Errorf("dca: journal is required")
+	}
+
+	next := make([]time.Time, len(s.plans))
+	now := time.Now()
+	for i, plan := range s.plans {
+		next[i] = plan.Schedule.Next(now)
+	}
+
+	for {
+		if len(next) == 0 {
+			<-ctx.Done()
+			return ctx.Err()
+		}
+
+		soonest := 0
+		for i := range next {
+			if next[i].Before(next[soonest]) {
+				soonest = i
+			}
+		}
+
+		timer := time.NewTimer(time.Until(next[soonest]))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+
+		plan := s.plans[soonest]
+		execution := s.ExecuteSlot(ctx, plan, next[soonest])
+		if err := s.Journal.Record(ctx, execution); err != nil && s.OnError != nil {
+			s.OnError(fmt.Errorf("dca: journal %s: %w", plan.Name, err))
+		}
+		if s.Notifier != nil {
+			if err := s.Notifier.Notify(ctx, ExecutionEvent(execution)); err != nil && s.OnError != nil {
+				s.OnError(fmt.Errorf("dca: notify %s: %w", plan.Name, err))
+			}
+		}
+		next[soonest] = plan.Schedule.Next(time.Now())
+	}
+}
+
+// Execute performs a single run of plan immediately, for a slot at the
+// current time, and returns its journal entry without recording it.
+func (s *Scheduler) Execute(ctx context.Context, plan Plan) Execution {
+	return s.ExecuteSlot(ctx, plan, time.Now())
+}
+
+// ExecuteSlot performs the run of plan scheduled for slot and returns its
+// journal entry without recording it.
+//
+// Behavior:
+//   - Skips the run when the DstCurrency wallet balance is below Budget.
+//   - Sizes the order as Budget / best ask, rounded down to
+//     AmountPrecision.
+//   - Sends a ClientOrderId derived from the plan name and slot rather
+//     than the wall clock, so every attempt at one slot, including a rerun
+//     after a restart, carries the same id and CreateOrder may retry it.
+func (s *Scheduler) ExecuteSlot(ctx context.Context, plan Plan, slot time.Time) Execution {
+	execution := Execution{
+		Plan:   plan.Name,
+		At:     time.Now(),
+		Slot:   slot,
+		Budget: plan.Budget,
+	}
+	fail := func(status string, format string, args ...any) Execution {
+		execution.Status = status
+		execution.Reason = fmt.Sprintf(format, args...)
+		return execution
+	}
+
+	budget, ok := new(big.Rat).SetString(plan.Budget)
+	if !ok || budget.Sign() <= 0 {
+		return fail(StatusFailed, "invalid budget %q", plan.Budget)
+	}
+
+	wallets, err := s.Exchange.GetWallets(t.GetWalletParams{Currencies: []string{plan.DstCurrency}}, nobitex.WithContext(ctx))
+	if err != nil {
+		return fail(StatusFailed, "fetching balance: %v", err)
+	}
+	balance, ok := new(big.Rat).SetString(wallets.Wallets[strings.ToLower(plan.DstCurrency)].Balance)
+	if !ok || balance.Cmp(budget) < 0 {
+		return fail(StatusSkipped, "insufficient %s balance for budget %s", plan.DstCurrency, plan.Budget)
+	}
+
+	book, err := s.Exchange.GetOrderBook(nobitex.MarketSymbol(plan.SrcCurrency, plan.DstCurrency), nobitex.WithContext(ctx))
+	if err != nil {
+		return fail(StatusFailed, "fetching order book: %v", err)
+	}
+	if len(book.Asks) == 0 || len(book.Asks[0]) == 0 {
+		return fail(StatusSkipped, "order book has no asks")
+	}
+	ask, ok := new(big.Rat).SetString(book.Asks[0][0])
+	if !ok || ask.Sign() <= 0 {
+		return fail(StatusFailed, "invalid best ask %q", book.Asks[0][0])
+	}
+
+	price := ask
+	if plan.Execution == "limit" {
+		price = new(big.Rat).Mul(ask, new(big.Rat).SetFloat64(1-plan.LimitOffset))
+		if plan.PricePrecision != "" {
+			step, _ := t.ParsePrecision(plan.PricePrecision)
+			price = nobitex.FloorToStep(price, step.Step)
+		}
+	}
+
+	amount := new(big.Rat).Quo(budget, price)
+	amountPrecision := plan.AmountPrecision
+	if amountPrecision == "" {
+		amountPrecision = "0.00000001"
+	}
+	step, _ := t.ParsePrecision(amountPrecision)
+	amount = nobitex.FloorToStep(amount, step.Step)
+	if amount.Sign() <= 0 {
+		return fail(StatusSkipped, "budget %s buys less than one amount step", plan.Budget)
+	}
+
+	params := t.CreateOrderParams{
+		Execution:     "market",
+		SrcCurrency:   plan.SrcCurrency,
+		DstCurrency:   plan.DstCurrency,
+		Type:          "buy",
+		Amount:        nobitex.FormatDecimal(amount),
+		ClientOrderId: fmt.Sprintf("dca-%s-%d", plan.Name, slot.Unix()),
+	}
+	if plan.Execution == "limit" {
+		params.Execution = "limit"
+		params.Price = nobitex.FormatDecimal(price)
+	}
+
+	execution.Amount = params.Amount
+	execution.Price = nobitex.FormatDecimal(price)
+	execution.ClientOrderId = params.ClientOrderId
+
+	order, err := s.Exchange.CreateOrder(params, nobitex.WithContext(ctx))
+	if err != nil {
+		return fail(StatusFailed, "placing order: %v", err)
+	}
+
+	execution.Status = StatusPlaced
+	execution.OrderId = order.Order.Id
+	return execution
+}
+
+// ExecutionEvent converts a journal entry into a "dca.<status>"
+// notification whose Data is the Execution itself.
+func ExecutionEvent(execution Execution) notify.Event {
+	event := notify.Event{
+		Kind:    "dca." + execution.Status,
+		Subject: fmt.Sprintf("DCA %s %s", execution.Plan, execution.Status),
+		Message: execution.Reason,
+		Data:    execution,
+		At:      execution.At,
+	}
+	if execution.Status == StatusPlaced {
+		event.Message = fmt.Sprintf("order %d: %s @ %s", execution.OrderId, execution.Amount, execution.Price)
+	}
+	return event
+}
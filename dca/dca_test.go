@@ -0,0 +1,50 @@
+package dca
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	nobitex "github.com/darhelm/go-nobitex"
+	"github.com/darhelm/go-nobitex/types"
+)
+
+// fakeExchange has plenty of balance and a single ask, and records the
+// orders it receives.
+type fakeExchange struct {
+	orders []types.CreateOrderParams
+}
+
+func (f *fakeExchange) GetWallets(params types.GetWalletParams, opts ...nobitex.RequestOption) (*types.Wallets, error) {
+	return &types.Wallets{Wallets: map[string]types.Wallet{"usdt": {Balance: "1000"}}}, nil
+}
+
+func (f *fakeExchange) GetOrderBook(symbol string, opts ...nobitex.RequestOption) (*types.OrderBook, error) {
+	return &types.OrderBook{Asks: [][]string{{"50000", "1"}}}, nil
+}
+
+func (f *fakeExchange) CreateOrder(params types.CreateOrderParams, opts ...nobitex.RequestOption) (*types.OrderStatus, error) {
+	f.orders = append(f.orders, params)
+	return &types.OrderStatus{Status: "ok"}, nil
+}
+
+func TestClientOrderIdFollowsSlot(t *testing.T) {
+	exchange := &fakeExchange{}
+	s := &Scheduler{Exchange: exchange, Journal: &MemoryJournal{}}
+	plan := Plan{Name: "btc", SrcCurrency: "btc", DstCurrency: "usdt", Budget: "100"}
+	slot := time.Date(2026, 3, 7, 9, 0, 0, 0, time.UTC)
+
+	first := s.ExecuteSlot(context.Background(), plan, slot)
+	second := s.ExecuteSlot(context.Background(), plan, slot)
+
+	if first.Status != StatusPlaced || second.Status != StatusPlaced {
+		t.Fatalf("statuses %q, %q; want placed: %s %s", first.Status, second.Status, first.Reason, second.Reason)
+	}
+	want := "dca-btc-1772874000"
+	if exchange.orders[0].ClientOrderId != want || exchange.orders[1].ClientOrderId != want {
+		t.Fatalf("client order ids %q, %q; want %q for both", exchange.orders[0].ClientOrderId, exchange.orders[1].ClientOrderId, want)
+	}
+	if !second.Slot.Equal(slot) {
+		t.Fatalf("Slot = %v, want %v", second.Slot, slot)
+	}
+}
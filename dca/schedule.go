@@ -0,0 +1,61 @@
+package dca
+
+import "time"
+
+// Schedule computes run times for a Scheduler.
+type Schedule interface {
+	// Next returns the first run time strictly after after.
+	Next(after time.Time) time.Time
+}
+
+// ScheduleFunc adapts a plain function to the Schedule interface.
+type ScheduleFunc func(after time.Time) time.Time
+
+// Next calls f(after).
+func (f ScheduleFunc) Next(after time.Time) time.Time {
+	return f(after)
+}
+
+// Every runs at a fixed interval aligned to the Unix epoch, so restarts
+// keep the same cadence (Every(time.Hour) runs on the hour).
+func Every(interval time.Duration) Schedule {
+	return ScheduleFunc(func(after time.Time) time.Time {
+		return after.Truncate(interval).Add(interval)
+	})
+}
+
+// DailyAt runs once a day at hour:minute in loc.
+func DailyAt(hour, minute int, loc *time.Location) Schedule {
+	return ScheduleFunc(func(after time.Time) time.Time {
+		local := after.In(loc)
+		next := time.Date(local.Year(), local.Month(), local.Day(), hour, minute, 0, 0, loc)
+		if !next.After(after) {
+			next = next.AddDate(0, 0, 1)
+		}
+		return next
+	})
+}
+
+// WeeklyAt runs once a week on weekday at hour:minute in loc.
+func WeeklyAt(weekday time.Weekday, hour, minute int, loc *time.Location) Schedule {
+	daily := DailyAt(hour, minute, loc)
+	return ScheduleFunc(func(after time.Time) time.Time {
+		next := daily.Next(after)
+		for next.In(loc).Weekday() != weekday {
+			next = daily.Next(next)
+		}
+		return next
+	})
+}
+
+// MonthlyAt runs once a month on day (1-28) at hour:minute in loc.
+func MonthlyAt(day, hour, minute int, loc *time.Location) Schedule {
+	return ScheduleFunc(func(after time.Time) time.Time {
+		local := after.In(loc)
+		next := time.Date(local.Year(), local.Month(), day, hour, minute, 0, 0, loc)
+		if !next.After(after) {
+			next = next.AddDate(0, 1, 0)
+		}
+		return next
+	})
+}
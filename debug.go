@@ -0,0 +1,91 @@
+package nobitex
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httputil"
+	"os"
+	"regexp"
+	"sync"
+)
+
+// redacted replaces secret values in debug output.
+const redacted = "***"
+
+// secretHeaders lists headers whose values never appear in debug output.
+var secretHeaders = []string{"Authorization", "X-TOTP", "Cookie", "Set-Cookie"}
+
+// secretJSONFields matches JSON string fields that carry credentials: the
+// login password, TOTP codes and the API key issued by /auth/login/.
+var secretJSONFields = regexp.MustCompile(`("(?:password|key|otp|otpCode|totp)"\s*:\s*)"(?:[^"\\]|\\.)*"`)
+
+// secretFormFields matches the same credentials in query strings and
+// form-encoded bodies.
+var secretFormFields = regexp.MustCompile(`((?:^|[?&\s])(?:password|key|otp|totp)=)[^&\s]*`)
+
+// debugLogger serializes dump output so concurrent requests do not
+// interleave.
+type debugLogger struct {
+	mu sync.Mutex
+}
+
+// dumpRequest writes a masked dump of req to the debug writer.
+func (c *Client) dumpRequest(req *http.Request) {
+	clone := req.Clone(req.Context())
+	if req.GetBody != nil {
+		if body, err := req.GetBody(); err == nil {
+			clone.Body = body
+		}
+	}
+	maskHeaders(clone.Header)
+
+	dump, err := httputil.DumpRequestOut(clone, true)
+	if err != nil {
+		c.writeDebug(fmt.Sprintf("request dump failed: %v", err))
+		return
+	}
+	c.writeDebug(maskSecrets(string(dump)))
+}
+
+// dumpResponse writes a masked dump of resp to the debug writer. The body
+// is buffered and restored, so it can still be decoded afterwards.
+func (c *Client) dumpResponse(resp *http.Response) {
+	headers := resp.Header
+	resp.Header = resp.Header.Clone()
+	maskHeaders(resp.Header)
+
+	dump, err := httputil.DumpResponse(resp, true)
+	resp.Header = headers
+	if err != nil {
+		c.writeDebug(fmt.Sprintf("response dump failed: %v", err))
+		return
+	}
+	c.writeDebug(maskSecrets(string(dump)))
+}
+
+// writeDebug prints a single dump entry.
+func (c *Client) writeDebug(entry string) {
+	c.debugLog.mu.Lock()
+	defer c.debugLog.mu.Unlock()
+
+	writer := c.debugWriter
+	if writer == nil {
+		writer = os.Stderr
+	}
+	_, _ = fmt.Fprintf(writer, "[go-nobitex] %s\n\n", entry)
+}
+
+// maskHeaders replaces the values of secret headers in place.
+func maskHeaders(header http.Header) {
+	for _, key := range secretHeaders {
+		if header.Get(key) != "" {
+			header.Set(key, redacted)
+		}
+	}
+}
+
+// maskSecrets redacts credential values inside a dumped payload.
+func maskSecrets(dump string) string {
+	dump = secretJSONFields.ReplaceAllString(dump, `${1}"`+redacted+`"`)
+	return secretFormFields.ReplaceAllString(dump, `${1}`+redacted)
+}
@@ -0,0 +1,69 @@
+package nobitex
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"time"
+)
+
+// executeShared executes req through the client's singleflight group so that
+// concurrent callers requesting the same URL share one HTTP round trip.
+//
+// Behavior:
+//   - The first caller (leader) performs the request and buffers the body;
+//     every caller, including the leader, then runs its own handle over a
+//     private reader of that buffer.
+//   - Errors, including *APIError, are shared by all callers of the flight.
+//   - A caller whose context is canceled stops waiting immediately, while
+//     the flight itself continues for the remaining callers. The flight is
+//     bounded by the client timeout instead (see flightTimeout).
+func (c *Client) executeShared(req *http.Request, handle func(r io.Reader) error, ro *requestOptions) error {
+	ch := c.inflight.DoChan(req.URL.String(), func() (interface{}, error) {
+		// Detached from the leader, so its cancellation does not abort
+		// the request for the followers
+		ctx, cancel := context.WithTimeout(context.WithoutCancel(req.Context()), c.flightTimeout())
+		defer cancel()
+
+		var buf bytes.Buffer
+		err := c.execute(req.WithContext(ctx), func(r io.Reader) error {
+			_, err := buf.ReadFrom(r)
+			return err
+		}, ro)
+		return buf.Bytes(), err
+	})
+
+	select {
+	case <-req.Context().Done():
+		return &RequestError{
+			GoNobitexError: GoNobitexError{
+				Message: "failed to send request",
+				Err:     req.Context().Err(),
+			},
+			Operation: "sending request",
+		}
+	case res := <-ch:
+		if res.Err != nil {
+			return res.Err
+		}
+
+		if err := callHandle(handle, bytes.NewReader(res.Val.([]byte))); err != nil {
+			return decodeError(err)
+		}
+		return nil
+	}
+}
+
+// flightTimeout bounds a shared request, which no caller's context
+// cancels: the client timeout if set, then the HTTP client's Timeout,
+// otherwise DefaultTimeout.
+func (c *Client) flightTimeout() time.Duration {
+	if c.timeout > 0 {
+		return c.timeout
+	}
+	if c.HttpClient != nil && c.HttpClient.Timeout > 0 {
+		return c.HttpClient.Timeout
+	}
+	return DefaultTimeout
+}
@@ -0,0 +1,50 @@
+package nobitex
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestSharedFlightSurvivesLeaderCancel(t *testing.T) {
+	var hits atomic.Int32
+	arrived := make(chan struct{}, 1)
+	release := make(chan struct{})
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+		arrived <- struct{}{}
+		<-release
+		_, _ = w.Write([]byte(`{"status":"ok","stats":{}}`))
+	})
+	c := newTestClient(t, handler, ClientOptions{DeduplicateRequests: true})
+
+	call := func(ctx context.Context) error {
+		var out map[string]any
+		return c.ApiRequest("GET", "/market/stats", "", false, false, nil, &out, WithContext(ctx))
+	}
+
+	leaderCtx, cancelLeader := context.WithCancel(context.Background())
+	leader := make(chan error, 1)
+	go func() { leader <- call(leaderCtx) }()
+	<-arrived
+
+	follower := make(chan error, 1)
+	go func() { follower <- call(context.Background()) }()
+	time.Sleep(50 * time.Millisecond) // let the follower join the flight
+
+	cancelLeader()
+	if err := <-leader; !errors.Is(err, context.Canceled) {
+		t.Fatalf("leader error = %v, want context.Canceled", err)
+	}
+
+	close(release)
+	if err := <-follower; err != nil {
+		t.Fatalf("follower error = %v, want nil", err)
+	}
+	if got := hits.Load(); got != 1 {
+		t.Fatalf("server hits = %d, want 1", got)
+	}
+}
@@ -0,0 +1,80 @@
+package nobitex
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+
+	t "github.com/darhelm/go-nobitex/types"
+)
+
+// ErrDepositNotFound is returned by GetDeposit when no deposit of the
+// account matches the requested id or transaction hash.
+var ErrDepositNotFound = errors.New("deposit not found")
+
+// GetDeposit returns one deposit by its Nobitex id or on-chain
+// transaction hash.
+//
+// Parameters:
+//   - ctx: Context of the underlying GetDeposits requests.
+//   - idOrTxHash: A numeric Deposit.Id, or a Deposit.TxHash (compared
+//     case-insensitively).
+//
+// Returns:
+//   - The matching *t.Deposit.
+//   - ErrDepositNotFound (wrapped in a GoNobitexError) when the account
+//     has no such deposit yet, e.g. an on-chain transfer Nobitex has not
+//     detected.
+//
+// Behavior:
+//   - Nobitex has no single-deposit endpoint, so the newest-first deposit
+//     list is walked until a match. Recent deposits are found on the first
+//     page; an id lookup stops as soon as the listing passes below it.
+//
+// Example:
+//
+//	dep, err := client.GetDeposit(ctx, "0x5c50...e1a")
+//	if errors.Is(err, nobitex.ErrDepositNotFound) {
+//	    // not seen yet, retry later
+//	}
+//	fmt.Println(dep.IsConfirmed, dep.Confirmations)
+func (c *Client) GetDeposit(ctx context.Context, idOrTxHash string, opts ...RequestOption) (*t.Deposit, error) {
+	key := strings.TrimSpace(idOrTxHash)
+	if key == "" {
+		return nil, &GoNobitexError{Message: "deposit id or tx hash is required"}
+	}
+	id, err := strconv.Atoi(key)
+	byId := err == nil
+
+	opts = append([]RequestOption{WithContext(ctx)}, opts...)
+	params := t.GetDepositsParams{Page: 1}
+	for {
+		page, err := c.GetDeposits(params, opts...)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, deposit := range page.Deposits {
+			if byId && deposit.Id == id {
+				return &deposit, nil
+			}
+			if !byId && deposit.TxHash != "" && strings.EqualFold(deposit.TxHash, key) {
+				return &deposit, nil
+			}
+		}
+
+		if !page.HasNext || len(page.Deposits) == 0 {
+			break
+		}
+		if byId && page.Deposits[len(page.Deposits)-1].Id < id {
+			break
+		}
+		params.Page++
+	}
+
+	return nil, &GoNobitexError{
+		Message: "no deposit matches " + strconv.Quote(key),
+		Err:     ErrDepositNotFound,
+	}
+}
@@ -1,8 +1,15 @@
 package nobitex
 
 import (
+	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"html"
+	"net/http"
+	"regexp"
+	"strings"
+	"unicode/utf8"
 
 	t "github.com/darhelm/go-nobitex/types"
 )
@@ -48,6 +55,20 @@ type APIError struct {
 	Fields map[string][]string
 }
 
+// ErrBlocked is wrapped by errors for non-JSON 403 responses, typically a
+// CDN/WAF page (Cloudflare, ArvanCloud) refusing the client's IP or
+// request. Test for it with errors.Is.
+var ErrBlocked = errors.New("request blocked by CDN or firewall")
+
+// ErrGatewayError is wrapped by errors for non-JSON 5xx responses, i.e. an
+// error page served by a proxy or CDN in front of the API rather than by
+// Nobitex itself. Test for it with errors.Is.
+var ErrGatewayError = errors.New("gateway error from CDN or proxy")
+
+// maxBodySnippet bounds the body excerpt kept in APIError.Detail for
+// non-JSON responses.
+const maxBodySnippet = 200
+
 // parseErrorResponse creates the most complete APIError possible.
 // It attempts all documented + undocumented patterns.
 func parseErrorResponse(statusCode int, respBody []byte) *APIError {
@@ -56,6 +77,11 @@ func parseErrorResponse(statusCode int, respBody []byte) *APIError {
 		Fields:     make(map[string][]string),
 	}
 
+	// #0 — HTML and other non-JSON pages come from a CDN or proxy
+	if trimmed := bytes.TrimSpace(respBody); len(trimmed) > 0 && trimmed[0] != '{' && trimmed[0] != '[' {
+		return parseNonJSONError(statusCode, trimmed, apiErr)
+	}
+
 	// #1 — Attempt to parse official Nobitex error format
 	var base t.ErrorResponse
 	_ = json.Unmarshal(respBody, &base)
@@ -106,3 +132,71 @@ func parseErrorResponse(statusCode int, respBody []byte) *APIError {
 	apiErr.GoNobitexError.Message = apiErr.Message
 	return apiErr
 }
+
+var (
+	htmlTitle = regexp.MustCompile(`(?is)<title[^>]*>(.*?)</title>`)
+	htmlTag   = regexp.MustCompile(`(?s)<[^>]*>`)
+)
+
+// parseNonJSONError classifies an error page that is not a Nobitex JSON
+// payload. 403s wrap ErrBlocked and 5xx wrap ErrGatewayError; Detail holds
+// a short, tag-free excerpt of the page and Fields stays empty.
+func parseNonJSONError(statusCode int, body []byte, apiErr *APIError) *APIError {
+	apiErr.Detail = bodySnippet(body)
+
+	var kind error
+	switch {
+	case statusCode == http.StatusForbidden:
+		kind = ErrBlocked
+	case statusCode >= 500:
+		kind = ErrGatewayError
+	}
+
+	apiErr.Message = fmt.Sprintf("non-JSON response (%d)", statusCode)
+	if kind != nil {
+		apiErr.Message = fmt.Sprintf("%s (%d)", kind, statusCode)
+	}
+	if provider := cdnProvider(body); provider != "" {
+		apiErr.Message += " from " + provider
+	}
+	if apiErr.Detail != "" {
+		apiErr.Message += ": " + apiErr.Detail
+	}
+
+	apiErr.GoNobitexError = GoNobitexError{Message: apiErr.Message, Err: kind}
+	return apiErr
+}
+
+// bodySnippet returns the page title, or failing that its text, with
+// whitespace collapsed and cut to maxBodySnippet bytes.
+func bodySnippet(body []byte) string {
+	text := string(body)
+	if m := htmlTitle.FindStringSubmatch(text); m != nil && strings.TrimSpace(m[1]) != "" {
+		text = m[1]
+	} else {
+		text = htmlTag.ReplaceAllString(text, " ")
+	}
+	text = strings.Join(strings.Fields(html.UnescapeString(text)), " ")
+
+	if len(text) > maxBodySnippet {
+		cut := maxBodySnippet
+		for cut > 0 && !utf8.RuneStart(text[cut]) {
+			cut--
+		}
+		text = text[:cut] + "…"
+	}
+	return text
+}
+
+// cdnProvider names the CDN that served an error page, if recognizable.
+func cdnProvider(body []byte) string {
+	lower := bytes.ToLower(body)
+	switch {
+	case bytes.Contains(lower, []byte("cloudflare")):
+		return "Cloudflare"
+	case bytes.Contains(lower, []byte("arvancloud")), bytes.Contains(lower, []byte("arvan cloud")):
+		return "ArvanCloud"
+	default:
+		return ""
+	}
+}
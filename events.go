@@ -0,0 +1,392 @@
+package nobitex
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/darhelm/go-nobitex/store"
+	t "github.com/darhelm/go-nobitex/types"
+)
+
+// OrderEventType identifies an order lifecycle transition.
+type OrderEventType string
+
+const (
+	// OrderPlaced is published when CreateOrder succeeds.
+	OrderPlaced OrderEventType = "placed"
+
+	// OrderRejected is published when Nobitex refuses a CreateOrder call
+	// or a RiskLimits check stops it before it is sent.
+	OrderRejected OrderEventType = "rejected"
+
+	// OrderPartiallyFilled is published when polling observes a new fill
+	// that leaves part of the order open.
+	OrderPartiallyFilled OrderEventType = "partially_filled"
+
+	// OrderFilled is published when the order is completely matched.
+	OrderFilled OrderEventType = "filled"
+
+	// OrderCanceled is published when CancelOrder succeeds or polling
+	// observes a canceled order.
+	OrderCanceled OrderEventType = "canceled"
+)
+
+// OrderEvent describes one lifecycle transition of an order.
+type OrderEvent struct {
+	// Type is the transition.
+	Type OrderEventType
+
+	// OrderId and ClientOrderId identify the order; either may be empty
+	// when the event was derived from a call that only carried the other.
+	OrderId       int
+	ClientOrderId string
+
+	// Order is the latest known state of the order, if any.
+	Order t.OrderStatusResponse
+
+	// Err is the API error or *RiskError of an OrderRejected event.
+	Err error
+
+	// At is when the transition was observed.
+	At time.Time
+}
+
+// EventBus fans order events out to subscribers. The zero value is ready
+// to use and every Client owns one (see Client.Events).
+type EventBus struct {
+	mu          sync.Mutex
+	handlers    map[int]func(OrderEvent)
+	nextHandler int
+
+	// watched holds orders tracked by WatchOrders, keyed by order id.
+	watched map[int]t.OrderStatusResponse
+
+	// onPanic receives panics recovered from subscribers.
+	onPanic func(error)
+
+	// store, if set, mirrors watched. storeMu serializes its writes.
+	store   store.Store
+	storeMu sync.Mutex
+
+	// onStoreError receives failed store writes.
+	onStoreError func(error)
+}
+
+// Subscribe registers fn for every event and returns a function that
+// unregisters it. fn is called synchronously from the publishing
+// goroutine and must not block. A panicking fn is recovered and does not
+// prevent delivery to the remaining subscribers.
+func (b *EventBus) Subscribe(fn func(OrderEvent)) (unsubscribe func()) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.handlers == nil {
+		b.handlers = make(map[int]func(OrderEvent))
+	}
+	b.nextHandler++
+	id := b.nextHandler
+	b.handlers[id] = fn
+
+	return func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		delete(b.handlers, id)
+	}
+}
+
+// Channel returns a buffered channel receiving every event until ctx is
+// done. Events are dropped when the buffer is full.
+func (b *EventBus) Channel(ctx context.Context, size int) <-chan OrderEvent {
+	ch := make(chan OrderEvent, size)
+	var mu sync.Mutex
+	closed := false
+
+	unsubscribe := b.Subscribe(func(ev OrderEvent) {
+		mu.Lock()
+		defer mu.Unlock()
+		if closed {
+			return
+		}
+		select {
+		case ch <- ev:
+		default:
+		}
+	})
+
+	go func() {
+		<-ctx.Done()
+		unsubscribe()
+		mu.Lock()
+		closed = true
+		close(ch)
+		mu.Unlock()
+	}()
+
+	return ch
+}
+
+// Publish delivers ev to every subscriber.
+func (b *EventBus) Publish(ev OrderEvent) {
+	if ev.At.IsZero() {
+		ev.At = time.Now()
+	}
+
+	b.mu.Lock()
+	handlers := make([]func(OrderEvent), 0, len(b.handlers))
+	for _, fn := range b.handlers {
+		handlers = append(handlers, fn)
+	}
+	b.mu.Unlock()
+
+	for _, fn := range handlers {
+		safeHook("event subscriber", b.onPanic, func() { fn(ev) })
+	}
+}
+
+// track starts watching an order for status transitions.
+func (b *EventBus) track(order t.OrderStatusResponse) {
+	b.mu.Lock()
+	if b.watched == nil {
+		b.watched = make(map[int]t.OrderStatusResponse)
+	}
+	b.watched[order.Id] = order
+	b.mu.Unlock()
+
+	b.persist(order.Id)
+}
+
+// untrack stops watching an order.
+func (b *EventBus) untrack(id int) {
+	b.mu.Lock()
+	delete(b.watched, id)
+	b.mu.Unlock()
+
+	b.persist(id)
+}
+
+// persist mirrors the tracked state of order id into the store, outside
+// mu so a slow store does not stall publishing. Writes are serialized and
+// always store the latest state, so a track and an untrack racing each
+// other cannot leave a stale entry behind. Failures are reported to
+// onStoreError.
+func (b *EventBus) persist(id int) {
+	if b.store == nil {
+		return
+	}
+
+	b.storeMu.Lock()
+	defer b.storeMu.Unlock()
+
+	b.mu.Lock()
+	order, ok := b.watched[id]
+	b.mu.Unlock()
+
+	key := strconv.Itoa(id)
+	var err error
+	if ok {
+		err = store.PutJSON(context.Background(), b.store, store.BucketOrders, key, order)
+	} else {
+		err = b.store.Delete(context.Background(), store.BucketOrders, key)
+	}
+	if err != nil && b.onStoreError != nil {
+		storeErr := &GoNobitexError{Message: fmt.Sprintf("persisting tracked order %d", id), Err: err}
+		safeHook("store error hook", b.onPanic, func() { b.onStoreError(storeErr) })
+	}
+}
+
+// lookup finds a watched order by id or, when id is zero, by client
+// order id.
+func (b *EventBus) lookup(id int, clientOrderId string) (t.OrderStatusResponse, bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if id != 0 {
+		order, ok := b.watched[id]
+		return order, ok
+	}
+	if clientOrderId == "" {
+		return t.OrderStatusResponse{}, false
+	}
+	for _, order := range b.watched {
+		if order.ClientOrderId == clientOrderId {
+			return order, true
+		}
+	}
+	return t.OrderStatusResponse{}, false
+}
+
+// tracked returns a snapshot of the watched orders.
+func (b *EventBus) tracked() []t.OrderStatusResponse {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	orders := make([]t.OrderStatusResponse, 0, len(b.watched))
+	for _, order := range b.watched {
+		orders = append(orders, order)
+	}
+	return orders
+}
+
+// RestoreOrders reloads the orders persisted in ClientOptions.Store by a
+// previous process, so WatchOrders keeps following them after a restart.
+// It returns the number of restored orders.
+//
+// Example:
+//
+//	if _, err := client.RestoreOrders(ctx); err != nil {
+//	    return err
+//	}
+//	go client.WatchOrders(ctx, 2*time.Second)
+func (c *Client) RestoreOrders(ctx context.Context) (int, error) {
+	b := &c.events
+	if b.store == nil {
+		return 0, nil
+	}
+
+	keys, err := b.store.Keys(ctx, store.BucketOrders)
+	if err != nil {
+		return 0, err
+	}
+
+	var restored int
+	for _, key := range keys {
+		var order t.OrderStatusResponse
+		if err := store.GetJSON(ctx, b.store, store.BucketOrders, key, &order); err != nil {
+			if errors.Is(err, store.ErrNotFound) {
+				continue
+			}
+			return restored, err
+		}
+
+		b.mu.Lock()
+		if b.watched == nil {
+			b.watched = make(map[int]t.OrderStatusResponse)
+		}
+		b.watched[order.Id] = order
+		b.mu.Unlock()
+		restored++
+	}
+	return restored, nil
+}
+
+// Events returns the client's order event bus. CreateOrder and
+// CancelOrder publish to it; WatchOrders adds fill and cancel events
+// discovered by polling.
+//
+// Example:
+//
+//	unsubscribe := client.Events().Subscribe(func(ev nobitex.OrderEvent) {
+//	    log.Printf("order %d: %s", ev.OrderId, ev.Type)
+//	})
+//	defer unsubscribe()
+//	go client.WatchOrders(ctx, 2*time.Second)
+func (c *Client) Events() *EventBus {
+	return &c.events
+}
+
+// publishCreateOrder publishes the outcome of a CreateOrder call.
+func (c *Client) publishCreateOrder(params t.CreateOrderParams, status *t.OrderStatus, err error) {
+	if err != nil {
+		var apiErr *APIError
+		if errors.As(err, &apiErr) || errors.Is(err, ErrRiskLimit) {
+			c.events.Publish(OrderEvent{
+				Type:          OrderRejected,
+				ClientOrderId: params.ClientOrderId,
+				Err:           err,
+			})
+		}
+		return
+	}
+
+	c.events.Publish(OrderEvent{
+		Type:          OrderPlaced,
+		OrderId:       status.Order.Id,
+		ClientOrderId: status.Order.ClientOrderId,
+		Order:         status.Order,
+	})
+	c.events.track(status.Order)
+	c.publishOrderState(t.OrderStatusResponse{Id: status.Order.Id, UnmatchedAmount: status.Order.Amount}, status.Order)
+}
+
+// publishCancelOrder publishes a successful CancelOrder call.
+// An order canceled by ClientOrderId is resolved to its tracked order, so
+// it is untracked and the event carries both ids.
+func (c *Client) publishCancelOrder(params t.CancelOrderParams) {
+	order, ok := c.events.lookup(params.Id, params.ClientOrderId)
+	if !ok {
+		order = t.OrderStatusResponse{Id: params.Id, ClientOrderId: params.ClientOrderId}
+	}
+
+	if order.Id != 0 {
+		c.events.untrack(order.Id)
+	}
+	c.events.Publish(OrderEvent{
+		Type:          OrderCanceled,
+		OrderId:       order.Id,
+		ClientOrderId: order.ClientOrderId,
+	})
+}
+
+// WatchOrders polls the status of every order placed through this client
+// that is still open, every interval, and publishes OrderPartiallyFilled,
+// OrderFilled and OrderCanceled transitions. It returns ctx.Err() once ctx
+// is done, or ErrClientClosed once the client is closed.
+//
+// Behavior:
+//   - Orders stop being polled once they are filled or canceled.
+//   - Failed status requests are skipped and retried on the next tick.
+func (c *Client) WatchOrders(ctx context.Context, interval time.Duration) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-c.Done():
+			return ErrClientClosed
+		case <-ticker.C:
+		}
+
+		for _, prev := range c.events.tracked() {
+			status, err := c.GetOrderStatus(t.GetOrderStatusParams{Id: prev.Id}, WithContext(ctx))
+			if err != nil {
+				continue
+			}
+			c.publishOrderState(prev, status.Order)
+		}
+	}
+}
+
+// publishOrderState compares two states of an order and publishes the
+// resulting transition, if any. Amounts are compared numerically, as the
+// Reconciler does, so "0.10" and "0.1" are the same unmatched amount.
+func (c *Client) publishOrderState(prev, curr t.OrderStatusResponse) {
+	ev := OrderEvent{
+		OrderId:       curr.Id,
+		ClientOrderId: curr.ClientOrderId,
+		Order:         curr,
+	}
+
+	switch strings.ToLower(curr.Status) {
+	case "done":
+		c.events.untrack(curr.Id)
+		ev.Type = OrderFilled
+	case "canceled":
+		c.events.untrack(curr.Id)
+		ev.Type = OrderCanceled
+	default:
+		c.events.track(curr)
+		if sameAmount(curr.UnmatchedAmount, prev.UnmatchedAmount) || sameAmount(curr.UnmatchedAmount, curr.Amount) {
+			return
+		}
+		ev.Type = OrderPartiallyFilled
+	}
+
+	c.events.Publish(ev)
+}
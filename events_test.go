@@ -0,0 +1,126 @@
+package nobitex
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/darhelm/go-nobitex/store"
+	"github.com/darhelm/go-nobitex/types"
+)
+
+// blockingStore fails every write after unblock is closed.
+type blockingStore struct {
+	store.MemoryStore
+	entered chan struct{}
+	unblock chan struct{}
+}
+
+var errStoreDown = errors.New("store down")
+
+func (s *blockingStore) Put(ctx context.Context, bucket, key string, value []byte) error {
+	s.entered <- struct{}{}
+	<-s.unblock
+	return errStoreDown
+}
+
+func TestTrackPersistsOutsideLock(t *testing.T) {
+	s := &blockingStore{entered: make(chan struct{}, 1), unblock: make(chan struct{})}
+	reported := make(chan error, 1)
+	b := &EventBus{store: s, onStoreError: func(err error) { reported <- err }}
+
+	done := make(chan struct{})
+	go func() {
+		b.track(orderWithId(7))
+		close(done)
+	}()
+	<-s.entered
+
+	// The bus must stay usable while the store write is in progress
+	delivered := make(chan struct{})
+	go func() {
+		b.Subscribe(func(OrderEvent) {})
+		_ = b.tracked()
+		close(delivered)
+	}()
+	select {
+	case <-delivered:
+	case <-time.After(time.Second):
+		t.Fatal("event bus blocked by a slow store write")
+	}
+
+	close(s.unblock)
+	<-done
+	select {
+	case err := <-reported:
+		if !errors.Is(err, errStoreDown) {
+			t.Fatalf("reported %v, want errStoreDown", err)
+		}
+	default:
+		t.Fatal("store failure was not reported")
+	}
+	if len(b.tracked()) != 1 {
+		t.Fatal("order not tracked in memory after a store failure")
+	}
+}
+
+func TestUntrackRemovesPersistedOrder(t *testing.T) {
+	var s store.MemoryStore
+	b := &EventBus{store: &s}
+
+	b.track(orderWithId(7))
+	b.untrack(7)
+
+	keys, err := s.Keys(context.Background(), store.BucketOrders)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(keys) != 0 {
+		t.Fatalf("store keys = %v after untrack, want none", keys)
+	}
+}
+
+func orderWithId(id int) types.OrderStatusResponse {
+	return types.OrderStatusResponse{Id: id}
+}
+
+func TestCancelByClientOrderIdUntracks(t *testing.T) {
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"status":"ok"}`))
+	}), ClientOptions{})
+	c.events.track(types.OrderStatusResponse{Id: 5, ClientOrderId: "grid-5"})
+
+	var events []OrderEvent
+	c.Events().Subscribe(func(ev OrderEvent) { events = append(events, ev) })
+
+	if _, err := c.CancelOrder(types.CancelOrderParams{ClientOrderId: "grid-5"}); err != nil {
+		t.Fatal(err)
+	}
+	if n := len(c.events.tracked()); n != 0 {
+		t.Fatalf("%d orders still tracked after cancel", n)
+	}
+	if len(events) != 1 || events[0].OrderId != 5 || events[0].ClientOrderId != "grid-5" {
+		t.Fatalf("events = %+v, want one OrderCanceled for order 5", events)
+	}
+}
+
+func TestOrderStateComparesAmountsNumerically(t *testing.T) {
+	c := newTestClient(t, http.NotFoundHandler(), ClientOptions{})
+
+	var events []OrderEvent
+	c.Events().Subscribe(func(ev OrderEvent) { events = append(events, ev) })
+
+	prev := types.OrderStatusResponse{Id: 1, Status: "Active", Amount: "1", UnmatchedAmount: "0.10"}
+	c.publishOrderState(prev, types.OrderStatusResponse{Id: 1, Status: "Active", Amount: "1", UnmatchedAmount: "0.1"})
+	c.publishOrderState(prev, types.OrderStatusResponse{Id: 1, Status: "Active", Amount: "1.0", UnmatchedAmount: "1"})
+	if len(events) != 0 {
+		t.Fatalf("events = %+v, want none for reformatted amounts", events)
+	}
+
+	c.publishOrderState(prev, types.OrderStatusResponse{Id: 1, Status: "Active", Amount: "1", UnmatchedAmount: "0.05"})
+	if len(events) != 1 || events[0].Type != OrderPartiallyFilled {
+		t.Fatalf("events = %+v, want one OrderPartiallyFilled", events)
+	}
+}
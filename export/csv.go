@@ -0,0 +1,269 @@
+// Package export converts Nobitex account data into formats consumed by
+// spreadsheets, accounting and tax tools.
+package export
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	t "github.com/darhelm/go-nobitex/types"
+)
+
+// RialUnit selects how rial-denominated values are written.
+type RialUnit int
+
+const (
+	// Rial writes values exactly as Nobitex returns them (IRR).
+	Rial RialUnit = iota
+
+	// Toman divides rial-denominated values by 10.
+	Toman
+)
+
+// CSVOptions configures a CSV writer.
+type CSVOptions struct {
+	// Columns selects and orders the written columns. Empty means all
+	// columns of the record type, in their default order.
+	Columns []string
+
+	// RialUnit controls normalization of prices, totals and fees quoted
+	// in rials ("rls").
+	RialUnit RialUnit
+
+	// SkipHeader omits the header row, e.g. when appending to a file.
+	SkipHeader bool
+
+	// TimeFormat formats timestamp columns. Defaults to time.RFC3339.
+	TimeFormat string
+}
+
+// TradeColumns lists the columns available for user trades.
+var TradeColumns = []string{
+	"id", "orderId", "market", "srcCurrency", "dstCurrency", "timestamp",
+	"type", "price", "amount", "total", "fee",
+}
+
+// OrderColumns lists the columns available for orders.
+var OrderColumns = []string{
+	"id", "clientOrderId", "type", "execution", "status", "srcCurrency",
+	"dstCurrency", "price", "amount", "matchedAmount", "averagePrice", "fee",
+	"createdAt",
+}
+
+// Writer streams records of type T as CSV rows.
+type Writer[T any] struct {
+	csv     *csv.Writer
+	columns []string
+	opts    CSVOptions
+	format  func(record T, column string, opts CSVOptions) string
+	started bool
+}
+
+// NewTradesWriter returns a Writer emitting one row per user trade.
+//
+// Example:
+//
+//	w, err := export.NewTradesWriter(file, export.CSVOptions{RialUnit: export.Toman})
+//	if err != nil {
+//	    return err
+//	}
+//	for trade, err := range client.UserTrades(ctx, t.GetUserTradesParams{}) {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    if err := w.Write(trade); err != nil {
+//	        return err
+//	    }
+//	}
+//	return w.Flush()
+func NewTradesWriter(w io.Writer, opts CSVOptions) (*Writer[t.UserTradeResponse], error) {
+	return newWriter(w, opts, TradeColumns, formatTrade)
+}
+
+// NewOrdersWriter returns a Writer emitting one row per order.
+func NewOrdersWriter(w io.Writer, opts CSVOptions) (*Writer[t.OrdersListResponse], error) {
+	return newWriter(w, opts, OrderColumns, formatOrder)
+}
+
+// newWriter validates the column selection and builds a Writer.
+func newWriter[T any](w io.Writer, opts CSVOptions, available []string, format func(T, string, CSVOptions) string) (*Writer[T], error) {
+	columns := opts.Columns
+	if len(columns) == 0 {
+		columns = available
+	}
+
+	for _, column := range columns {
+		if !slices.Contains(available, column) {
+			return nil, fmt.Errorf("unknown column %q (available: %s)", column, strings.Join(available, ", "))
+		}
+	}
+
+	if opts.TimeFormat == "" {
+		opts.TimeFormat = time.RFC3339
+	}
+
+	return &Writer[T]{
+		csv:     csv.NewWriter(w),
+		columns: columns,
+		opts:    opts,
+		format:  format,
+	}, nil
+}
+
+// Write appends one record, writing the header row first if needed.
+func (w *Writer[T]) Write(record T) error {
+	if !w.started {
+		w.started = true
+		if !w.opts.SkipHeader {
+			if err := w.csv.Write(w.columns); err != nil {
+				return err
+			}
+		}
+	}
+
+	row := make([]string, len(w.columns))
+	for i, column := range w.columns {
+		row[i] = w.format(record, column, w.opts)
+	}
+	return w.csv.Write(row)
+}
+
+// WriteAll writes every record and flushes the output.
+func (w *Writer[T]) WriteAll(records []T) error {
+	for _, record := range records {
+		if err := w.Write(record); err != nil {
+			return err
+		}
+	}
+	return w.Flush()
+}
+
+// Flush writes any buffered rows to the underlying writer.
+func (w *Writer[T]) Flush() error {
+	w.csv.Flush()
+	return w.csv.Error()
+}
+
+// formatTrade renders one column of a user trade.
+func formatTrade(trade t.UserTradeResponse, column string, opts CSVOptions) string {
+	rialQuoted := trade.DstCurrency == "rls"
+
+	switch column {
+	case "id":
+		return strconv.Itoa(trade.Id)
+	case "orderId":
+		return trade.OrderId
+	case "market":
+		return trade.Market
+	case "srcCurrency":
+		return trade.SrcCurrency
+	case "dstCurrency":
+		return trade.DstCurrency
+	case "timestamp":
+		return trade.Timestamp.Format(opts.TimeFormat)
+	case "type":
+		return trade.Type
+	case "price":
+		return normalizeRial(trade.Price, rialQuoted, opts.RialUnit)
+	case "amount":
+		return trade.Amount
+	case "total":
+		return normalizeRial(string(trade.Total), rialQuoted, opts.RialUnit)
+	case "fee":
+		// Sell fees are charged in the quote currency, buy fees in the base
+		return normalizeRial(trade.Fee, rialQuoted && trade.Type == "sell", opts.RialUnit)
+	default:
+		return ""
+	}
+}
+
+// formatOrder renders one column of an order.
+func formatOrder(order t.OrdersListResponse, column string, opts CSVOptions) string {
+	rialQuoted := order.DstCurrency == "rls"
+
+	switch column {
+	case "id":
+		return strconv.Itoa(order.Id)
+	case "clientOrderId":
+		return order.ClientOrderId
+	case "type":
+		return order.Type
+	case "execution":
+		return order.Execution
+	case "status":
+		return order.Status
+	case "srcCurrency":
+		return order.SrcCurrency
+	case "dstCurrency":
+		return order.DstCurrency
+	case "price":
+		return normalizeRial(string(order.Price), rialQuoted, opts.RialUnit)
+	case "amount":
+		return order.Amount
+	case "matchedAmount":
+		return order.MatchedAmount
+	case "averagePrice":
+		return normalizeRial(order.AveragePrice, rialQuoted, opts.RialUnit)
+	case "fee":
+		return normalizeRial(order.Fee, rialQuoted && order.Type == "sell", opts.RialUnit)
+	case "createdAt":
+		if order.CreatedAt.IsZero() {
+			return ""
+		}
+		return order.CreatedAt.Format(opts.TimeFormat)
+	default:
+		return ""
+	}
+}
+
+// normalizeRial converts a rial amount to the requested unit. Non-rial
+// values and non-numeric strings (e.g. "market") are returned unchanged.
+func normalizeRial(value string, isRial bool, unit RialUnit) string {
+	if !isRial || unit != Toman {
+		return value
+	}
+	return shiftDecimal(value, 1)
+}
+
+// shiftDecimal divides a decimal string by 10^places without going through
+// floating point, so no precision is lost.
+func shiftDecimal(value string, places int) string {
+	negative := strings.HasPrefix(value, "-")
+	digits := strings.TrimPrefix(value, "-")
+
+	intPart, fracPart, _ := strings.Cut(digits, ".")
+	if intPart == "" && fracPart == "" {
+		return value
+	}
+	for _, r := range intPart + fracPart {
+		if r < '0' || r > '9' {
+			return value
+		}
+	}
+
+	for len(intPart) <= places {
+		intPart = "0" + intPart
+	}
+	split := len(intPart) - places
+	intPart, fracPart = intPart[:split], intPart[split:]+fracPart
+
+	intPart = strings.TrimLeft(intPart, "0")
+	if intPart == "" {
+		intPart = "0"
+	}
+	fracPart = strings.TrimRight(fracPart, "0")
+
+	result := intPart
+	if fracPart != "" {
+		result += "." + fracPart
+	}
+	if negative && result != "0" {
+		result = "-" + result
+	}
+	return result
+}
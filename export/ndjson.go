@@ -0,0 +1,183 @@
+package export
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"iter"
+	"time"
+
+	t "github.com/darhelm/go-nobitex/types"
+)
+
+// NDJSONWriter streams records of type T as newline-delimited JSON, one
+// object per line. Output is buffered; call Flush when done.
+type NDJSONWriter[T any] struct {
+	buf *bufio.Writer
+	enc *json.Encoder
+}
+
+// NewNDJSONWriter returns a writer encoding any record type.
+//
+// Example:
+//
+//	w := export.NewNDJSONWriter[t.Candle](os.Stdout)
+//	if err := w.WriteAll(history.Bars()); err != nil {
+//	    return err
+//	}
+func NewNDJSONWriter[T any](w io.Writer) *NDJSONWriter[T] {
+	buf := bufio.NewWriter(w)
+	enc := json.NewEncoder(buf)
+	enc.SetEscapeHTML(false)
+	return &NDJSONWriter[T]{buf: buf, enc: enc}
+}
+
+// NewTradesNDJSON returns a writer for user trades.
+func NewTradesNDJSON(w io.Writer) *NDJSONWriter[t.UserTradeResponse] {
+	return NewNDJSONWriter[t.UserTradeResponse](w)
+}
+
+// NewMarketTradesNDJSON returns a writer for public market trades.
+func NewMarketTradesNDJSON(w io.Writer) *NDJSONWriter[t.Trade] {
+	return NewNDJSONWriter[t.Trade](w)
+}
+
+// NewCandlesNDJSON returns a writer for OHLCV bars.
+func NewCandlesNDJSON(w io.Writer) *NDJSONWriter[t.Candle] {
+	return NewNDJSONWriter[t.Candle](w)
+}
+
+// NewLedgerNDJSON returns a writer for ledger entries.
+func NewLedgerNDJSON(w io.Writer) *NDJSONWriter[LedgerEntry] {
+	return NewNDJSONWriter[LedgerEntry](w)
+}
+
+// Write encodes one record as a line.
+func (w *NDJSONWriter[T]) Write(record T) error {
+	return w.enc.Encode(record)
+}
+
+// WriteAll writes every record and flushes the output.
+func (w *NDJSONWriter[T]) WriteAll(records []T) error {
+	for _, record := range records {
+		if err := w.Write(record); err != nil {
+			return err
+		}
+	}
+	return w.Flush()
+}
+
+// WriteSeq drains seq, such as client.UserTrades, writing every record,
+// and flushes the output. It stops at the first error from seq or the
+// writer and returns the number of records written.
+//
+// Example:
+//
+//	w := export.NewTradesNDJSON(file)
+//	n, err := w.WriteSeq(client.UserTrades(ctx, t.GetUserTradesParams{}))
+func (w *NDJSONWriter[T]) WriteSeq(seq iter.Seq2[T, error]) (int, error) {
+	var n int
+	for record, err := range seq {
+		if err != nil {
+			_ = w.Flush()
+			return n, err
+		}
+		if err := w.Write(record); err != nil {
+			return n, err
+		}
+		n++
+	}
+	return n, w.Flush()
+}
+
+// Flush writes any buffered lines to the underlying writer.
+func (w *NDJSONWriter[T]) Flush() error {
+	return w.buf.Flush()
+}
+
+// Ledger entry kinds.
+const (
+	LedgerDeposit    = "deposit"
+	LedgerWithdrawal = "withdrawal"
+)
+
+// LedgerEntry is one balance movement of a wallet, normalized across
+// deposits and withdrawals.
+type LedgerEntry struct {
+	Kind     string    `json:"kind"`
+	Id       int       `json:"id"`
+	WalletId int       `json:"walletId"`
+	Currency string    `json:"currency"`
+	Amount   string    `json:"amount"`
+	Status   string    `json:"status"`
+	Network  string    `json:"network,omitempty"`
+	Address  string    `json:"address,omitempty"`
+	TxHash   string    `json:"txHash,omitempty"`
+	Time     time.Time `json:"time"`
+}
+
+// DepositEntry converts a deposit into a ledger entry.
+func DepositEntry(deposit t.Deposit) LedgerEntry {
+	return LedgerEntry{
+		Kind:     LedgerDeposit,
+		Id:       deposit.Id,
+		WalletId: deposit.WalletId,
+		Currency: deposit.Currency,
+		Amount:   deposit.Amount,
+		Status:   deposit.Status,
+		Network:  string(deposit.Network),
+		Address:  deposit.Address,
+		TxHash:   deposit.TxHash,
+		Time:     deposit.Date,
+	}
+}
+
+// WithdrawalEntry converts a withdrawal into a ledger entry. Amount is
+// negated so entries can be summed into a balance change.
+func WithdrawalEntry(withdrawal t.Withdrawal) LedgerEntry {
+	return LedgerEntry{
+		Kind:     LedgerWithdrawal,
+		Id:       withdrawal.Id,
+		WalletId: withdrawal.WalletId,
+		Currency: withdrawal.Currency,
+		Amount:   negate(withdrawal.Amount),
+		Status:   withdrawal.Status,
+		Network:  string(withdrawal.Network),
+		Address:  withdrawal.Address,
+		TxHash:   withdrawal.TxHash,
+		Time:     withdrawal.CreatedAt,
+	}
+}
+
+// LedgerSeq adapts an iterator of deposits or withdrawals, such as
+// client.Deposits, into ledger entries for NDJSONWriter.WriteSeq.
+//
+// Example:
+//
+//	w := export.NewLedgerNDJSON(file)
+//	_, err := w.WriteSeq(export.LedgerSeq(client.Deposits(ctx, t.GetDepositsParams{}), export.DepositEntry))
+func LedgerSeq[T any](seq iter.Seq2[T, error], convert func(T) LedgerEntry) iter.Seq2[LedgerEntry, error] {
+	return func(yield func(LedgerEntry, error) bool) {
+		for record, err := range seq {
+			if err != nil {
+				yield(LedgerEntry{}, err)
+				return
+			}
+			if !yield(convert(record), nil) {
+				return
+			}
+		}
+	}
+}
+
+// negate flips the sign of a decimal string.
+func negate(value string) string {
+	switch {
+	case value == "" || value == "0":
+		return value
+	case value[0] == '-':
+		return value[1:]
+	default:
+		return "-" + value
+	}
+}
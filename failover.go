@@ -0,0 +1,171 @@
+package nobitex
+
+import (
+	"errors"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// DefaultFailoverCooldown is how long a base URL that failed is skipped
+// before it is tried again, unless overridden through
+// ClientOptions.FailoverCooldown.
+const DefaultFailoverCooldown = 30 * time.Second
+
+// failoverPool tracks mirror base URLs and the health of every base URL the
+// client talks to.
+type failoverPool struct {
+	mu        sync.Mutex
+	mirrors   []string
+	cooldown  time.Duration
+	downUntil map[string]time.Time
+}
+
+// candidates returns the base URLs to try for one call: primary first, then
+// mirrors in configured order. Base URLs in cooldown are moved to the end
+// (soonest recovery first) so a call is still attempted when all are down.
+func (p *failoverPool) candidates(primary string) []string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	all := append([]string{primary}, p.mirrors...)
+	now := time.Now()
+
+	healthy := make([]string, 0, len(all))
+	var down []string
+	for _, base := range all {
+		if until, ok := p.downUntil[base]; ok && now.Before(until) {
+			down = append(down, base)
+			continue
+		}
+		healthy = append(healthy, base)
+	}
+
+	// Insertion sort by recovery time; the list is tiny
+	for i := 1; i < len(down); i++ {
+		for j := i; j > 0 && p.downUntil[down[j]].Before(p.downUntil[down[j-1]]); j-- {
+			down[j], down[j-1] = down[j-1], down[j]
+		}
+	}
+
+	return append(healthy, down...)
+}
+
+// markDown puts base into cooldown.
+func (p *failoverPool) markDown(base string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.downUntil == nil {
+		p.downUntil = make(map[string]time.Time)
+	}
+
+	cooldown := p.cooldown
+	if cooldown <= 0 {
+		cooldown = DefaultFailoverCooldown
+	}
+	p.downUntil[base] = time.Now().Add(cooldown)
+}
+
+// markUp clears any cooldown recorded for base.
+func (p *failoverPool) markUp(base string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	delete(p.downUntil, base)
+}
+
+// hasMirrors reports whether failover is configured at all.
+func (p *failoverPool) hasMirrors() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return len(p.mirrors) > 0
+}
+
+// ActiveBaseUrl returns the base URL the next API call will be sent to:
+// BaseUrl while it is healthy, otherwise the first healthy mirror.
+func (c *Client) ActiveBaseUrl() string {
+	return c.failover.candidates(c.BaseUrl)[0]
+}
+
+// IsTestnet reports whether the client talks to TestnetBaseUrl.
+func (c *Client) IsTestnet() bool {
+	return c.BaseUrl == TestnetBaseUrl
+}
+
+// withFailover runs call against each candidate base URL until one succeeds
+// or fails with an error that another base URL would not fix.
+//
+// Behavior:
+//   - Without mirrors configured, call runs exactly once against BaseUrl.
+//   - A base URL that fails over is put into cooldown so subsequent calls
+//     go straight to the next healthy one.
+//   - Idempotent methods fail over on any transport error and on 502/503/504.
+//   - Other methods (e.g. POST /market/orders/add) only fail over when the
+//     connection could not be established, so an order is never submitted
+//     twice to different mirrors.
+//   - A canceled or expired caller context stops failover immediately.
+func (c *Client) withFailover(method string, ro *requestOptions, call func(base string) error) error {
+	if !c.failover.hasMirrors() {
+		return call(c.BaseUrl)
+	}
+
+	var err error
+	for _, base := range c.failover.candidates(c.BaseUrl) {
+		err = call(base)
+		if err == nil {
+			c.failover.markUp(base)
+			return nil
+		}
+
+		if ro.ctx.Err() != nil || !shouldFailover(method, err) {
+			return err
+		}
+		c.failover.markDown(base)
+		c.count(CounterFailovers, &c.counters.failovers)
+	}
+
+	return err
+}
+
+// shouldFailover classifies err as a base-URL-level failure.
+func shouldFailover(method string, err error) bool {
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) {
+		return true
+	}
+
+	var opErr *net.OpError
+	if errors.As(err, &opErr) && opErr.Op == "dial" {
+		return true
+	}
+
+	if !isIdempotent(method) {
+		return false
+	}
+
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		switch apiErr.StatusCode {
+		case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+			return true
+		}
+		return false
+	}
+
+	var reqErr *RequestError
+	return errors.As(err, &reqErr) && reqErr.Operation == "sending request"
+}
+
+// isIdempotent reports whether repeating a request with method cannot
+// cause additional side effects.
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
@@ -1,10 +1,11 @@
 module github.com/darhelm/go-nobitex
 
-go 1.21
+go 1.23
 
 require (
 	github.com/golang-jwt/jwt/v4 v4.5.2
 	github.com/pquerna/otp v1.5.0
+	golang.org/x/sync v0.11.0
 )
 
 require github.com/boombuler/barcode v1.0.1-0.20190219062509-6c824513bacc // indirect
@@ -0,0 +1,170 @@
+package nobitex
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	t "github.com/darhelm/go-nobitex/types"
+)
+
+// GetSystemStatus checks whether the Nobitex API is reachable and whether a
+// maintenance window is in effect, so orchestration can gate trading on
+// exchange health.
+//
+// Endpoint:
+//
+//	GET /check/health
+//
+// Returns:
+//   - *t.SystemStatus containing:
+//     Status
+//     IsMaintenance / Message
+//     MaintenanceStart / MaintenanceEnd
+//     Latency (measured round-trip time)
+//   - An error if the API cannot be reached, responds with an error, or
+//     answers with a null body (wrapping ErrEmptyResponse).
+//
+// Behavior:
+//   - No authentication required.
+//   - Never served from a cache.
+//   - Updates the client's maintenance state (see InMaintenance).
+//
+// Example:
+//
+//	st, err := client.GetSystemStatus()
+//	if err != nil || st.IsMaintenance {
+//	    pauseTrading()
+//	}
+func (c *Client) GetSystemStatus(opts ...RequestOption) (*t.SystemStatus, error) {
+	var status *t.SystemStatus
+
+	start := time.Now()
+	err := c.ApiRequest("GET", "/check/health", "", false, false, nil, &status, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if status == nil {
+		// A null body says nothing about maintenance, so the state is kept
+		return nil, &RequestError{
+			GoNobitexError: GoNobitexError{
+				Message: "status response is null",
+				Err:     ErrEmptyResponse,
+			},
+			Operation: "parsing response",
+		}
+	}
+
+	status.Latency = time.Since(start)
+	if status.IsMaintenance {
+		c.enterMaintenance()
+	} else {
+		c.exitMaintenance()
+	}
+	return status, nil
+}
+
+// Ping is a lightweight availability check returning the round-trip time
+// of a status request.
+//
+// Returns:
+//   - The measured latency.
+//   - An error if the API is unreachable, responds with an error, or
+//     reports maintenance mode (wrapping ErrMaintenance).
+//
+// Example:
+//
+//	latency, err := client.Ping(nobitex.WithContext(ctx))
+//	if err != nil {
+//	    return err
+//	}
+//	fmt.Println("nobitex rtt:", latency)
+func (c *Client) Ping(opts ...RequestOption) (time.Duration, error) {
+	status, err := c.GetSystemStatus(opts...)
+	if err != nil {
+		return 0, err
+	}
+
+	if status.IsMaintenance {
+		message := "status check failed"
+		if status.Message != "" {
+			message = status.Message
+		}
+		return status.Latency, &GoNobitexError{Message: message, Err: ErrMaintenance}
+	}
+	return status.Latency, nil
+}
+
+// healthState holds the outcome of the most recent background probe.
+type healthState struct {
+	mu      sync.Mutex
+	probed  bool
+	lastErr error
+	checked time.Time
+}
+
+// Healthy reports whether the exchange looked usable at the last
+// background probe (see ClientOptions.HealthProbeInterval). It is false
+// while the client is in maintenance mode. Without a prober, or before its
+// first probe completes, it only reflects the maintenance state.
+//
+// Example:
+//
+//	if !client.Healthy() {
+//	    log.Printf("skipping rebalance: %v", client.LastError())
+//	    return
+//	}
+func (c *Client) Healthy() bool {
+	if active, _ := c.InMaintenance(); active {
+		return false
+	}
+
+	c.health.mu.Lock()
+	defer c.health.mu.Unlock()
+
+	return !c.health.probed || c.health.lastErr == nil
+}
+
+// LastError returns the error of the last background probe, or nil if it
+// succeeded or no probe has run yet.
+func (c *Client) LastError() error {
+	c.health.mu.Lock()
+	defer c.health.mu.Unlock()
+
+	return c.health.lastErr
+}
+
+// LastHealthCheck returns when the last background probe completed, or
+// the zero time if none has.
+func (c *Client) LastHealthCheck() time.Time {
+	c.health.mu.Lock()
+	defer c.health.mu.Unlock()
+
+	return c.health.checked
+}
+
+// probeHealth pings the API every interval until the client is closed.
+// Each ping is bounded by the interval, so a hung request cannot stall the
+// probe.
+func (c *Client) probeHealth(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		ctx, cancel := context.WithTimeout(context.Background(), interval)
+		_, err := c.Ping(WithContext(ctx))
+		cancel()
+
+		c.health.mu.Lock()
+		c.health.probed = true
+		c.health.lastErr = err
+		c.health.checked = time.Now()
+		c.health.mu.Unlock()
+
+		select {
+		case <-c.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,21 @@
+package nobitex
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+)
+
+func TestGetSystemStatusNullBody(t *testing.T) {
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`null`))
+	}), ClientOptions{})
+
+	status, err := c.GetSystemStatus()
+	if !errors.Is(err, ErrEmptyResponse) {
+		t.Fatalf("GetSystemStatus() = %+v, %v, want ErrEmptyResponse", status, err)
+	}
+	if _, err := c.Ping(); err == nil {
+		t.Fatal("Ping succeeded on a null status")
+	}
+}
@@ -0,0 +1,523 @@
+package nobitex
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"strconv"
+	"time"
+
+	t "github.com/darhelm/go-nobitex/types"
+)
+
+// TradesIterOptions bounds how far a TradesIter walks the trade history.
+// Zero values mean "no bound".
+type TradesIterOptions struct {
+	// UntilTime stops iteration before the first trade executed after it.
+	UntilTime time.Time
+
+	// UntilId stops iteration before the first trade whose Id exceeds it.
+	UntilId int
+
+	// Cursor resumes iteration from a token previously returned by
+	// TradesIter.Cursor. It takes precedence over params.FromId.
+	Cursor string
+
+	// OnCheckpoint, if set, is called with a fresh resume token every time
+	// a page has been fully consumed.
+	OnCheckpoint func(cursor string)
+}
+
+// TradesIter walks the authenticated user's complete trade history page by
+// page, following the FromId/HasNext pagination of /market/trades/list.
+//
+// Usage follows the bufio.Scanner pattern:
+//
+//	it := client.NewTradesIter(ctx, t.GetUserTradesParams{SrcCurrency: "btc", DstCurrency: "rls"}, nobitex.TradesIterOptions{})
+//	for it.Next() {
+//	    trade := it.Trade()
+//	    fmt.Println(trade.Id, trade.Price)
+//	}
+//	if err := it.Err(); err != nil {
+//	    return err
+//	}
+type TradesIter struct {
+	client *Client
+	ctx    context.Context
+	params t.GetUserTradesParams
+	opts   TradesIterOptions
+
+	page    []t.UserTradeResponse
+	pos     int
+	current t.UserTradeResponse
+	nextId  int
+	hasNext bool
+	pastTo  bool
+	started bool
+	done    bool
+	err     error
+
+	checkpoint Cursor
+}
+
+// NewTradesIter returns an iterator over the user's trades matching params.
+//
+// Parameters:
+//   - ctx: Cancels iteration; checked before every page request.
+//   - params: Currency filters. params.FromId, if set, is the first trade Id
+//     to return; later pages are requested automatically.
+//   - opts: Optional stop conditions (timestamp or trade Id).
+//
+// Behavior:
+//   - No request is made until the first call to Next.
+//   - Each page request starts at one past the highest trade Id seen so
+//     far, so trades are yielded in the order Nobitex returns them within
+//     a page while pages advance towards newer trades.
+//   - Trades outside params.From/params.To are skipped. When params.From is
+//     set without FromId or a cursor, the first page is located by an
+//     exponential probe and binary search over trade ids (about
+//     2·log2(max trade id) requests) instead of walking the history
+//     before From.
+//   - Trades after params.To are skipped rather than ending iteration, as
+//     ids only roughly follow execution time; iteration stops after a
+//     page whose earliest trade is already past params.To.
+//   - Iteration ends when the API reports hasNext=false, a stop condition is
+//     met, ctx is done, or a request fails (see Err).
+//   - An invalid opts.Cursor is reported by Err on the first call to Next.
+func (c *Client) NewTradesIter(ctx context.Context, params t.GetUserTradesParams, opts TradesIterOptions) *TradesIter {
+	it := &TradesIter{
+		client: c,
+		ctx:    ctx,
+		params: params,
+		opts:   opts,
+	}
+
+	cursor, err := decodeCursorFor(opts.Cursor, CursorTrades)
+	if err != nil {
+		it.err = err
+		it.done = true
+		return it
+	}
+
+	if cursor.FromId > 0 {
+		it.params.FromId = strconv.FormatInt(cursor.FromId, 10)
+	}
+	it.checkpoint = cursor
+	return it
+}
+
+// Next advances to the next trade, fetching a new page when needed. It
+// returns false when iteration is finished or an error occurred.
+func (it *TradesIter) Next() bool {
+	for !it.done {
+		for it.pos >= len(it.page) {
+			if it.started {
+				it.advanceCheckpoint()
+			}
+			if it.started && (!it.hasNext || it.pastTo) {
+				it.done = true
+				return false
+			}
+			if !it.fetch() {
+				it.done = true
+				return false
+			}
+		}
+
+		trade := it.page[it.pos]
+		it.pos++
+
+		if it.opts.UntilId > 0 && trade.Id > it.opts.UntilId {
+			it.done = true
+			return false
+		}
+		if !it.opts.UntilTime.IsZero() && trade.Timestamp.After(it.opts.UntilTime) {
+			it.done = true
+			return false
+		}
+		if !inTimeRange(trade.Timestamp, it.params.From, it.params.To) {
+			continue
+		}
+
+		it.current = trade
+		return true
+	}
+
+	return false
+}
+
+// Trade returns the trade the iterator is positioned at.
+func (it *TradesIter) Trade() t.UserTradeResponse {
+	return it.current
+}
+
+// Err returns the first error that stopped iteration, if any. A canceled
+// context is reported as ctx.Err().
+func (it *TradesIter) Err() error {
+	return it.err
+}
+
+// Cursor returns a resume token for the iterator's position. It only moves
+// at page boundaries, so resuming from it never skips a trade; trades of a
+// partially consumed page are yielded again.
+//
+// Example:
+//
+//	for it.Next() {
+//	    store(it.Trade())
+//	}
+//	saveToken(it.Cursor())
+func (it *TradesIter) Cursor() string {
+	return it.checkpoint.Encode()
+}
+
+// advanceCheckpoint moves the checkpoint past the page just consumed.
+func (it *TradesIter) advanceCheckpoint() {
+	if int64(it.nextId) <= it.checkpoint.FromId {
+		return
+	}
+
+	it.checkpoint.Endpoint = CursorTrades
+	it.checkpoint.FromId = int64(it.nextId)
+	if it.current.Timestamp.After(it.checkpoint.Time) {
+		it.checkpoint.Time = it.current.Timestamp
+	}
+
+	if it.opts.OnCheckpoint != nil {
+		it.opts.OnCheckpoint(it.checkpoint.Encode())
+	}
+}
+
+// fetch loads the next page. It reports false when nothing more can be read.
+func (it *TradesIter) fetch() bool {
+	if err := it.ctx.Err(); err != nil {
+		it.err = err
+		return false
+	}
+
+	if !it.started && it.params.FromId == "" && !it.params.From.IsZero() {
+		id, err := it.client.seekTradeId(it.ctx, it.params, it.params.From)
+		if err != nil {
+			it.err = err
+			return false
+		}
+		if id > 0 {
+			it.params.FromId = strconv.Itoa(id)
+		}
+	}
+
+	params := it.params
+	if it.started {
+		params.FromId = strconv.Itoa(it.nextId)
+	}
+
+	res, err := it.client.userTradesPage(params, WithContext(it.ctx))
+	if err != nil {
+		it.err = err
+		return false
+	}
+
+	it.started = true
+	it.page = res.Trades
+	it.pos = 0
+	it.hasNext = res.HasNext
+
+	maxId := it.nextId - 1
+	var earliest time.Time
+	for i, trade := range res.Trades {
+		if trade.Id > maxId {
+			maxId = trade.Id
+		}
+		if i == 0 || trade.Timestamp.Before(earliest) {
+			earliest = trade.Timestamp
+		}
+	}
+	it.pastTo = !it.params.To.IsZero() && len(res.Trades) > 0 && earliest.After(it.params.To)
+
+	// Guard against a page that does not advance the cursor
+	if maxId+1 <= it.nextId {
+		it.hasNext = false
+	}
+	it.nextId = maxId + 1
+
+	return len(it.page) > 0
+}
+
+// ResumeOptions makes a range-over-func iterator restartable. Long-running
+// sync daemons persist every checkpoint and pass the latest one back after
+// a restart, so no page is downloaded twice.
+//
+// Example:
+//
+//	resume := nobitex.ResumeOptions{
+//	    Cursor:       loadToken(),
+//	    OnCheckpoint: saveToken,
+//	}
+//	for order, err := range client.OrdersFrom(ctx, params, resume) {
+//	    ...
+//	}
+type ResumeOptions struct {
+	// Cursor is a token previously passed to OnCheckpoint. Empty starts
+	// from the beginning of the listing.
+	Cursor string
+
+	// OnCheckpoint, if set, receives a resume token after every page whose
+	// elements have all been yielded.
+	OnCheckpoint func(cursor string)
+}
+
+// checkpoint reports cursor to OnCheckpoint, if set.
+func (r ResumeOptions) checkpoint(cursor Cursor) {
+	if r.OnCheckpoint != nil {
+		r.OnCheckpoint(cursor.Encode())
+	}
+}
+
+// UserTrades returns a range-over-func iterator over the user's complete
+// trade history, driven by a TradesIter.
+//
+// Behavior:
+//   - Pages are fetched lazily; breaking out of the loop stops fetching.
+//   - A request error is yielded once as (zero value, err) and ends the loop.
+//
+// Example:
+//
+//	for trade, err := range client.UserTrades(ctx, t.GetUserTradesParams{}) {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    fmt.Println(trade.Id, trade.Amount)
+//	}
+func (c *Client) UserTrades(ctx context.Context, params t.GetUserTradesParams) iter.Seq2[t.UserTradeResponse, error] {
+	return c.UserTradesFrom(ctx, params, ResumeOptions{})
+}
+
+// UserTradesFrom is the resumable form of UserTrades: it starts at
+// resume.Cursor and reports a new token through resume.OnCheckpoint after
+// every fully yielded page.
+func (c *Client) UserTradesFrom(ctx context.Context, params t.GetUserTradesParams, resume ResumeOptions) iter.Seq2[t.UserTradeResponse, error] {
+	return func(yield func(t.UserTradeResponse, error) bool) {
+		it := c.NewTradesIter(ctx, params, TradesIterOptions{
+			Cursor:       resume.Cursor,
+			OnCheckpoint: resume.OnCheckpoint,
+		})
+		for it.Next() {
+			if !yield(it.Trade(), nil) {
+				return
+			}
+		}
+		if err := it.Err(); err != nil {
+			yield(t.UserTradeResponse{}, err)
+		}
+	}
+}
+
+// Orders returns a range-over-func iterator over every order matching
+// params, paginating /market/orders/list by order Id.
+//
+// Behavior:
+//   - params.Order defaults to t.SortIdAsc (oldest first) so pages can be
+//     chained with FromId = highest Id seen + 1; other sort keys are
+//     rejected.
+//   - Orders created outside params.From/params.To are skipped.
+//   - Iteration ends when a page yields no new orders, ctx is done, the
+//     loop body breaks, or a request fails (yielded once as an error).
+//
+// Example:
+//
+//	for order, err := range client.Orders(ctx, t.GetOrdersListParams{Status: "all"}) {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    fmt.Println(order.Id, order.Status)
+//	}
+func (c *Client) Orders(ctx context.Context, params t.GetOrdersListParams) iter.Seq2[t.OrdersListResponse, error] {
+	return c.OrdersFrom(ctx, params, ResumeOptions{})
+}
+
+// OrdersFrom is the resumable form of Orders: it starts at resume.Cursor
+// (overriding params.FromId) and reports a new token through
+// resume.OnCheckpoint after every fully yielded page.
+func (c *Client) OrdersFrom(ctx context.Context, params t.GetOrdersListParams, resume ResumeOptions) iter.Seq2[t.OrdersListResponse, error] {
+	return func(yield func(t.OrdersListResponse, error) bool) {
+		cursor, err := decodeCursorFor(resume.Cursor, CursorOrders)
+		if err != nil {
+			yield(t.OrdersListResponse{}, err)
+			return
+		}
+		if cursor.FromId > 0 {
+			params.FromId = cursor.FromId
+		}
+
+		if params.Order == "" {
+			params.Order = t.SortIdAsc
+		}
+		if params.Order != t.SortIdAsc {
+			yield(t.OrdersListResponse{}, &GoNobitexError{
+				Message: fmt.Sprintf("Orders paginates by id and requires Order %q, got %q", t.SortIdAsc, params.Order),
+			})
+			return
+		}
+
+		for {
+			if err := ctx.Err(); err != nil {
+				yield(t.OrdersListResponse{}, err)
+				return
+			}
+
+			page, err := c.GetOrdersList(params, WithContext(ctx))
+			if err != nil {
+				yield(t.OrdersListResponse{}, err)
+				return
+			}
+
+			maxId := params.FromId - 1
+			for _, order := range page.Orders {
+				if int64(order.Id) < params.FromId {
+					continue
+				}
+				if int64(order.Id) > maxId {
+					maxId = int64(order.Id)
+				}
+				if !inTimeRange(order.CreatedAt, params.From, params.To) {
+					continue
+				}
+				if !yield(order, nil) {
+					return
+				}
+			}
+
+			if maxId < params.FromId {
+				return
+			}
+			params.FromId = maxId + 1
+
+			cursor.FromId = params.FromId
+			resume.checkpoint(cursor)
+		}
+	}
+}
+
+// Deposits returns a range-over-func iterator over every deposit matching
+// params, walking pages until the API reports hasNext=false.
+//
+// Example:
+//
+//	for deposit, err := range client.Deposits(ctx, t.GetDepositsParams{}) {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    fmt.Println(deposit.TxHash, deposit.Amount)
+//	}
+func (c *Client) Deposits(ctx context.Context, params t.GetDepositsParams) iter.Seq2[t.Deposit, error] {
+	return c.DepositsFrom(ctx, params, ResumeOptions{})
+}
+
+// DepositsFrom is the resumable form of Deposits: it starts at the page stored in
+// resume.Cursor (overriding params.Page) and reports a new token through
+// resume.OnCheckpoint after every fully yielded page.
+func (c *Client) DepositsFrom(ctx context.Context, params t.GetDepositsParams, resume ResumeOptions) iter.Seq2[t.Deposit, error] {
+	return func(yield func(t.Deposit, error) bool) {
+		cursor, err := decodeCursorFor(resume.Cursor, CursorDeposits)
+		if err != nil {
+			yield(t.Deposit{}, err)
+			return
+		}
+		if cursor.Page > 0 {
+			params.Page = cursor.Page
+		}
+
+		if params.Page < 1 {
+			params.Page = 1
+		}
+
+		for {
+			if err := ctx.Err(); err != nil {
+				yield(t.Deposit{}, err)
+				return
+			}
+
+			page, err := c.GetDeposits(params, WithContext(ctx))
+			if err != nil {
+				yield(t.Deposit{}, err)
+				return
+			}
+
+			for _, deposit := range page.Deposits {
+				if !yield(deposit, nil) {
+					return
+				}
+			}
+
+			if !page.HasNext || len(page.Deposits) == 0 {
+				return
+			}
+			params.Page++
+
+			cursor.Page = params.Page
+			resume.checkpoint(cursor)
+		}
+	}
+}
+
+// Withdrawals returns a range-over-func iterator over every withdrawal
+// matching params, walking pages until the API reports hasNext=false.
+//
+// Example:
+//
+//	for withdrawal, err := range client.Withdrawals(ctx, t.GetWithdrawalsParams{}) {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    fmt.Println(withdrawal.Id, withdrawal.Status)
+//	}
+func (c *Client) Withdrawals(ctx context.Context, params t.GetWithdrawalsParams) iter.Seq2[t.Withdrawal, error] {
+	return c.WithdrawalsFrom(ctx, params, ResumeOptions{})
+}
+
+// WithdrawalsFrom is the resumable form of Withdrawals: it starts at the page stored in
+// resume.Cursor (overriding params.Page) and reports a new token through
+// resume.OnCheckpoint after every fully yielded page.
+func (c *Client) WithdrawalsFrom(ctx context.Context, params t.GetWithdrawalsParams, resume ResumeOptions) iter.Seq2[t.Withdrawal, error] {
+	return func(yield func(t.Withdrawal, error) bool) {
+		cursor, err := decodeCursorFor(resume.Cursor, CursorWithdrawals)
+		if err != nil {
+			yield(t.Withdrawal{}, err)
+			return
+		}
+		if cursor.Page > 0 {
+			params.Page = cursor.Page
+		}
+
+		if params.Page < 1 {
+			params.Page = 1
+		}
+
+		for {
+			if err := ctx.Err(); err != nil {
+				yield(t.Withdrawal{}, err)
+				return
+			}
+
+			page, err := c.GetWithdrawals(params, WithContext(ctx))
+			if err != nil {
+				yield(t.Withdrawal{}, err)
+				return
+			}
+
+			for _, withdrawal := range page.Withdrawals {
+				if !yield(withdrawal, nil) {
+					return
+				}
+			}
+
+			if !page.HasNext || len(page.Withdrawals) == 0 {
+				return
+			}
+			params.Page++
+
+			cursor.Page = params.Page
+			resume.checkpoint(cursor)
+		}
+	}
+}
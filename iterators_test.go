@@ -0,0 +1,52 @@
+package nobitex
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/darhelm/go-nobitex/types"
+)
+
+func TestTradesIterSkipsTradesAfterTo(t *testing.T) {
+	base := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	at := func(minutes int) time.Time { return base.Add(time.Duration(minutes) * time.Minute) }
+
+	// Ids only roughly follow time: trade 2 executed after To, trade 3 before
+	pages := map[string]types.UserTrades{
+		"": {Trades: []types.UserTradeResponse{
+			{Id: 1, Timestamp: at(10)}, {Id: 2, Timestamp: at(30)}, {Id: 3, Timestamp: at(15)},
+		}, HasNext: true},
+		"4": {Trades: []types.UserTradeResponse{
+			{Id: 4, Timestamp: at(25)}, {Id: 5, Timestamp: at(40)},
+		}, HasNext: true},
+		"6": {Trades: []types.UserTradeResponse{{Id: 6, Timestamp: at(18)}}},
+	}
+
+	var requests atomic.Int32
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		page := pages[r.URL.Query().Get("fromId")]
+		page.Status = "ok"
+		_ = json.NewEncoder(w).Encode(page)
+	}), ClientOptions{})
+
+	it := c.NewTradesIter(context.Background(), types.GetUserTradesParams{To: at(20)}, TradesIterOptions{})
+	var ids []int
+	for it.Next() {
+		ids = append(ids, it.Trade().Id)
+	}
+	if err := it.Err(); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(ids) != 2 || ids[0] != 1 || ids[1] != 3 {
+		t.Fatalf("yielded ids %v, want [1 3]", ids)
+	}
+	if n := requests.Load(); n != 2 {
+		t.Fatalf("made %d requests, want 2: the page past To ends iteration", n)
+	}
+}
@@ -0,0 +1,257 @@
+package nobitex
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// latencyWindow is how many recent samples per endpoint Stats computes
+// percentiles over, so a recent degradation is not averaged away by a long
+// healthy history.
+const latencyWindow = 1024
+
+// RequestInfo describes one completed HTTP exchange with the API. Retries
+// are reported individually.
+type RequestInfo struct {
+	// Method is the HTTP method.
+	Method string
+
+	// Endpoint is the request path with ids and market symbols replaced by
+	// placeholders, e.g. "/v3/orderbook/:symbol", so it can be used as a
+	// metric label.
+	Endpoint string
+
+	// StatusCode is the HTTP status, or 0 when no response was received.
+	StatusCode int
+
+	// Duration is the time from sending the request until the response
+	// body was consumed.
+	Duration time.Duration
+
+	// Err is the error of the exchange, if any.
+	Err error
+
+	// ErrorCode is the Nobitex error code of a rejected request, e.g.
+	// "OverValueOrder"; empty for successes and infrastructure failures.
+	ErrorCode string
+}
+
+// EndpointStats summarizes the latency of one endpoint.
+type EndpointStats struct {
+	// Count and Errors are lifetime totals.
+	Count  uint64
+	Errors uint64
+
+	// P50, P90, P99 and Max cover the most recent requests (up to 1024).
+	P50 time.Duration
+	P90 time.Duration
+	P99 time.Duration
+	Max time.Duration
+}
+
+// Stats is a snapshot of the client's request statistics.
+type Stats struct {
+	// Endpoints maps "METHOD /path" (see RequestInfo.Endpoint) to its
+	// latency summary.
+	Endpoints map[string]EndpointStats
+
+	// Connections holds the connection reuse counters.
+	Connections ConnStats
+
+	// ErrorCodes counts rejected requests by Nobitex error code, e.g.
+	// "OverValueOrder" or "TradeLimitation", separately from transport
+	// and server failures.
+	ErrorCodes map[string]uint64
+}
+
+// endpointLatency is the live record behind an EndpointStats.
+type endpointLatency struct {
+	count   uint64
+	errors  uint64
+	samples []time.Duration
+	next    int
+}
+
+// latencyTracker collects per-endpoint latencies and error codes. The
+// zero value is ready to use.
+type latencyTracker struct {
+	mu         sync.Mutex
+	endpoints  map[string]*endpointLatency
+	errorCodes map[string]uint64
+}
+
+// recordCode counts one rejection with code.
+func (l *latencyTracker) recordCode(code string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.errorCodes == nil {
+		l.errorCodes = make(map[string]uint64)
+	}
+	l.errorCodes[code]++
+}
+
+// codes returns a copy of the error code counts.
+func (l *latencyTracker) codes() map[string]uint64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return maps.Clone(l.errorCodes)
+}
+
+// record adds one sample.
+func (l *latencyTracker) record(key string, d time.Duration, failed bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.endpoints == nil {
+		l.endpoints = make(map[string]*endpointLatency)
+	}
+	e, ok := l.endpoints[key]
+	if !ok {
+		e = &endpointLatency{}
+		l.endpoints[key] = e
+	}
+
+	e.count++
+	if failed {
+		e.errors++
+	}
+	if len(e.samples) < latencyWindow {
+		e.samples = append(e.samples, d)
+	} else {
+		e.samples[e.next] = d
+		e.next = (e.next + 1) % latencyWindow
+	}
+}
+
+// snapshot computes the summaries of every endpoint.
+func (l *latencyTracker) snapshot() map[string]EndpointStats {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	stats := make(map[string]EndpointStats, len(l.endpoints))
+	for key, e := range l.endpoints {
+		sorted := slices.Clone(e.samples)
+		slices.Sort(sorted)
+		stats[key] = EndpointStats{
+			Count:  e.count,
+			Errors: e.errors,
+			P50:    percentile(sorted, 0.50),
+			P90:    percentile(sorted, 0.90),
+			P99:    percentile(sorted, 0.99),
+			Max:    percentile(sorted, 1),
+		}
+	}
+	return stats
+}
+
+// percentile returns the nearest-rank percentile of sorted samples.
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+	rank := int(p*float64(len(sorted))+0.999999) - 1
+	return sorted[max(0, min(rank, len(sorted)-1))]
+}
+
+// Stats returns per-endpoint latency percentiles and the connection
+// counters accumulated since the client was created.
+//
+// Example:
+//
+//	s := client.Stats()
+//	if add := s.Endpoints["POST /market/orders/add"]; add.P99 > time.Second {
+//	    log.Printf("orders/add p99 degraded: %v", add.P99)
+//	}
+func (c *Client) Stats() Stats {
+	return Stats{
+		Endpoints:   c.latency.snapshot(),
+		Connections: c.ConnStats(),
+		ErrorCodes:  c.latency.codes(),
+	}
+}
+
+// recordRequest feeds a completed exchange to Stats and the OnRequest
+// metrics hook.
+func (c *Client) recordRequest(req *http.Request, statusCode int, d time.Duration, err error) {
+	info := RequestInfo{
+		Method:     req.Method,
+		Endpoint:   endpointLabel(req.URL.Path),
+		StatusCode: statusCode,
+		Duration:   d,
+		Err:        err,
+	}
+
+	// Business errors answered by Nobitex are not latency failures
+	var apiErr *APIError
+	rejected := errors.As(err, &apiErr) && apiErr.StatusCode < 500
+	failed := err != nil && !rejected
+	c.latency.record(info.Method+" "+info.Endpoint, d, failed)
+	if rejected && apiErr.Code != "" {
+		info.ErrorCode = apiErr.Code
+		c.latency.recordCode(apiErr.Code)
+	}
+
+	c.count(CounterRequests, &c.counters.requests)
+	if err != nil {
+		c.count(CounterErrors, &c.counters.errors)
+	}
+
+	if c.metrics.OnRequest != nil {
+		safeHook("metrics hook", c.onPanic, func() {
+			c.metrics.OnRequest(info)
+		})
+	}
+
+	if c.slowThreshold > 0 && d > c.slowThreshold {
+		c.reportSlow(info)
+	}
+}
+
+// reportSlow reports a request slower than the configured threshold to
+// MetricsHook.OnSlowRequest, or writes a warning when that hook is nil.
+func (c *Client) reportSlow(info RequestInfo) {
+	if c.metrics.OnSlowRequest != nil {
+		safeHook("metrics hook", c.onPanic, func() {
+			c.metrics.OnSlowRequest(info)
+		})
+		return
+	}
+
+	c.writeDebug(fmt.Sprintf("warning: slow request %s %s took %s (threshold %s, status %d)",
+		info.Method, info.Endpoint, info.Duration.Round(time.Millisecond), c.slowThreshold, info.StatusCode))
+}
+
+// endpointLabel replaces the variable segments of a request path with
+// placeholders: numeric ids become ":id" and upper-case market symbols
+// ":symbol". Version segments such as "v3" are kept.
+func endpointLabel(path string) string {
+	segments := strings.Split(strings.TrimSuffix(path, "/"), "/")
+	for i, segment := range segments {
+		switch {
+		case segment == "":
+		case isDigits(segment):
+			segments[i] = ":id"
+		case segment == strings.ToUpper(segment) && segment != strings.ToLower(segment):
+			segments[i] = ":symbol"
+		}
+	}
+	return strings.Join(segments, "/")
+}
+
+// isDigits reports whether s consists of ASCII digits only.
+func isDigits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return s != ""
+}
@@ -0,0 +1,46 @@
+package nobitex
+
+import "context"
+
+// concurrencyLimiter caps the number of requests in flight at once. A nil
+// slots channel means unlimited.
+type concurrencyLimiter struct {
+	slots chan struct{}
+}
+
+// newConcurrencyLimiter returns a limiter allowing max concurrent
+// requests; max <= 0 disables the limit.
+func newConcurrencyLimiter(max int) concurrencyLimiter {
+	if max <= 0 {
+		return concurrencyLimiter{}
+	}
+	return concurrencyLimiter{slots: make(chan struct{}, max)}
+}
+
+// acquire waits for a free slot or until ctx is done.
+func (l concurrencyLimiter) acquire(ctx context.Context) error {
+	if l.slots == nil {
+		return nil
+	}
+
+	select {
+	case l.slots <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// release frees a slot taken by acquire.
+func (l concurrencyLimiter) release() {
+	if l.slots == nil {
+		return
+	}
+	<-l.slots
+}
+
+// InFlight returns the number of requests currently holding a concurrency
+// slot. It is always 0 when ClientOptions.MaxConcurrentRequests is unset.
+func (c *Client) InFlight() int {
+	return len(c.limiter.slots)
+}
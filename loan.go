@@ -0,0 +1,144 @@
+package nobitex
+
+import (
+	t "github.com/darhelm/go-nobitex/types"
+)
+
+// GetLoanPlans lists the crypto loan plans offered by Nobitex.
+//
+// Endpoint:
+//
+//	GET /loan/plan/list
+//
+// Parameters:
+//   - params: t.GetLoanPlansParams
+//     Currency (optional borrowed-asset filter)
+//
+// Returns:
+//   - *t.LoanPlans containing:
+//     Status
+//     Plans []LoanPlan (rates, terms and LTV thresholds)
+//
+// Behavior:
+//   - Requires authentication.
+//
+// Example:
+//
+//	plans, _ := client.GetLoanPlans(t.GetLoanPlansParams{Currency: "usdt"})
+//	for _, p := range plans.Plans {
+//	    fmt.Println(p.Id, p.InterestRate, p.LiquidationLTV)
+//	}
+func (c *Client) GetLoanPlans(params t.GetLoanPlansParams, opts ...RequestOption) (*t.LoanPlans, error) {
+	var plans *t.LoanPlans
+	err := c.ApiRequest("GET", "/loan/plan/list", "", true, false, params, &plans, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return plans, nil
+}
+
+// OpenLoan borrows from a plan against locked collateral.
+//
+// Endpoint:
+//
+//	POST /loan/create
+//
+// Parameters:
+//   - params: t.OpenLoanParams
+//     PlanId
+//     Amount
+//     CollateralCurrency / CollateralAmount
+//
+// Returns:
+//   - *t.LoanResponse containing:
+//     Status
+//     Loan → Loan
+//
+// Behavior:
+//   - Requires authentication.
+//   - Fails with an APIError when the collateral does not satisfy the
+//     plan's InitialLTV.
+//
+// Example:
+//
+//	loan, err := client.OpenLoan(t.OpenLoanParams{
+//	    PlanId:             3,
+//	    Amount:             "500",
+//	    CollateralCurrency: "btc",
+//	    CollateralAmount:   "0.02",
+//	})
+func (c *Client) OpenLoan(params t.OpenLoanParams, opts ...RequestOption) (*t.LoanResponse, error) {
+	var loan *t.LoanResponse
+	err := c.ApiRequest("POST", "/loan/create", "", true, false, params, &loan, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return loan, nil
+}
+
+// RepayLoan repays part or all of an open loan.
+//
+// Endpoint:
+//
+//	POST /loan/repay
+//
+// Parameters:
+//   - params: t.RepayLoanParams
+//     LoanId
+//     Amount (empty repays the full debt)
+//
+// Returns:
+//   - *t.LoanResponse with the updated loan.
+//
+// Behavior:
+//   - Requires authentication.
+//   - A full repayment releases the collateral.
+//
+// Example:
+//
+//	loan, err := client.RepayLoan(t.RepayLoanParams{LoanId: 42})
+func (c *Client) RepayLoan(params t.RepayLoanParams, opts ...RequestOption) (*t.LoanResponse, error) {
+	var loan *t.LoanResponse
+	err := c.ApiRequest("POST", "/loan/repay", "", true, false, params, &loan, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return loan, nil
+}
+
+// GetLoans retrieves one page of the user's loans with their collateral
+// status.
+//
+// Endpoint:
+//
+//	GET /loan/list
+//
+// Parameters:
+//   - params: t.GetLoansParams
+//     Status ("open", "repaid", "liquidated")
+//     Page / PageSize
+//
+// Returns:
+//   - *t.Loans containing:
+//     Status
+//     Loans []Loan (debt, CurrentLTV, LiquidationPrice, ...)
+//     HasNext bool
+//
+// Behavior:
+//   - Requires authentication.
+//   - Poll it to monitor CurrentLTV against MarginCallLTV/LiquidationLTV.
+//
+// Example:
+//
+//	loans, _ := client.GetLoans(t.GetLoansParams{Status: "open"})
+//	for _, l := range loans.Loans {
+//	    fmt.Println(l.Id, l.CurrentLTV, l.LiquidationPrice)
+//	}
+func (c *Client) GetLoans(params t.GetLoansParams, opts ...RequestOption) (*t.Loans, error) {
+	var loans *t.Loans
+	err := c.ApiRequest("GET", "/loan/list", "", true, false, params, &loans, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return loans, nil
+}
@@ -0,0 +1,175 @@
+package nobitex
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ErrMaintenance is wrapped by errors returned while Nobitex is in
+// maintenance mode. Test for it with errors.Is.
+var ErrMaintenance = errors.New("exchange is in maintenance mode")
+
+// DefaultMaintenanceProbeInterval is how often a degraded client probes the
+// API for recovery, unless overridden through
+// ClientOptions.MaintenanceProbeInterval.
+const DefaultMaintenanceProbeInterval = 30 * time.Second
+
+// maintenanceCodes lists API error codes Nobitex uses during maintenance.
+var maintenanceCodes = []string{"Maintenance", "UnderMaintenance", "ServiceUnavailable", "TradingUnavailable"}
+
+// maintenanceMarkers are matched case-insensitively against error bodies,
+// covering the HTML page served by the gateway during maintenance.
+var maintenanceMarkers = [][]byte{[]byte("maintenance"), []byte("در حال به‌روزرسانی"), []byte("بروزرسانی")}
+
+// maintenanceState tracks whether the client is degraded by maintenance.
+type maintenanceState struct {
+	mu       sync.Mutex
+	active   bool
+	since    time.Time
+	probing  bool
+	interval time.Duration
+}
+
+// InMaintenance reports whether the client has detected a maintenance
+// window and is failing calls fast, and since when.
+func (c *Client) InMaintenance() (bool, time.Time) {
+	c.maintenance.mu.Lock()
+	defer c.maintenance.mu.Unlock()
+
+	return c.maintenance.active, c.maintenance.since
+}
+
+// maintenanceError is returned for calls rejected while degraded.
+func maintenanceError() error {
+	return &GoNobitexError{
+		Message: "request not sent",
+		Err:     ErrMaintenance,
+	}
+}
+
+// isMaintenanceResponse reports whether an error response signals
+// maintenance rather than a regular failure.
+func isMaintenanceResponse(statusCode int, body []byte, apiErr *APIError) bool {
+	for _, code := range maintenanceCodes {
+		if apiErr.Code == code {
+			return true
+		}
+	}
+
+	if statusCode != http.StatusServiceUnavailable {
+		return false
+	}
+
+	lower := bytes.ToLower(body)
+	for _, marker := range maintenanceMarkers {
+		if bytes.Contains(lower, marker) {
+			return true
+		}
+	}
+	return false
+}
+
+// enterMaintenance puts the client into the degraded state and starts the
+// background recovery probe if it is not already running.
+func (c *Client) enterMaintenance() {
+	c.maintenance.mu.Lock()
+	entered := !c.maintenance.active
+	if entered {
+		c.maintenance.active = true
+		c.maintenance.since = time.Now()
+	}
+	startProbe := !c.maintenance.probing
+	c.maintenance.probing = true
+	c.maintenance.mu.Unlock()
+
+	// Counted unlocked: the reporter may call back into InMaintenance
+	if entered {
+		c.count(CounterMaintenance, &c.counters.maintenance)
+	}
+	if startProbe {
+		go c.probeMaintenance()
+	}
+}
+
+// exitMaintenance leaves the degraded state.
+func (c *Client) exitMaintenance() {
+	c.maintenance.mu.Lock()
+	defer c.maintenance.mu.Unlock()
+
+	c.maintenance.active = false
+	c.maintenance.since = time.Time{}
+}
+
+// probeMaintenance polls the status endpoint until the API answers without
+// a maintenance flag, then clears the degraded state.
+func (c *Client) probeMaintenance() {
+	interval := c.maintenance.interval
+	if interval <= 0 {
+		interval = DefaultMaintenanceProbeInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-c.Done():
+			c.maintenance.mu.Lock()
+			c.maintenance.probing = false
+			c.maintenance.mu.Unlock()
+			return
+		case <-ticker.C:
+		}
+
+		status, err := c.GetSystemStatus(withMaintenanceBypass())
+		if err != nil || status.IsMaintenance {
+			continue
+		}
+
+		if c.stopProbing() {
+			return
+		}
+	}
+}
+
+// stopProbing clears the probing flag unless the client is in maintenance
+// again. GetSystemStatus leaves maintenance before the probe gets here; a
+// request may have re-entered it since, seen probing set and started no
+// probe of its own, so the probe must keep running in that case.
+func (c *Client) stopProbing() bool {
+	c.maintenance.mu.Lock()
+	defer c.maintenance.mu.Unlock()
+
+	if c.maintenance.active {
+		return false
+	}
+	c.maintenance.probing = false
+	return true
+}
+
+// withMaintenanceBypass lets a request through while the client is degraded.
+func withMaintenanceBypass() RequestOption {
+	return func(o *requestOptions) {
+		o.bypassMaintenance = true
+	}
+}
+
+// markMaintenance inspects an error response and degrades the client when
+// it signals maintenance. It returns the error to hand to the caller.
+func (c *Client) markMaintenance(statusCode int, body []byte, apiErr *APIError) error {
+	if !isMaintenanceResponse(statusCode, body, apiErr) {
+		return apiErr
+	}
+
+	c.enterMaintenance()
+	message := apiErr.Message
+	if message == "" || strings.HasPrefix(message, "API error") {
+		message = "exchange reported maintenance"
+	}
+	apiErr.GoNobitexError = GoNobitexError{Message: message, Err: ErrMaintenance}
+	return apiErr
+}
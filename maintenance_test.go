@@ -0,0 +1,111 @@
+package nobitex
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+// newTestClient returns a client talking to handler, authenticated with a
+// dummy API key.
+func newTestClient(tb testing.TB, handler http.Handler, opts ClientOptions) *Client {
+	tb.Helper()
+
+	srv := httptest.NewServer(handler)
+	tb.Cleanup(srv.Close)
+
+	opts.BaseUrl = srv.URL
+	if opts.ApiKey == "" {
+		opts.ApiKey = "test-key"
+	}
+	client, err := NewClient(opts)
+	if err != nil {
+		tb.Fatalf("NewClient: %v", err)
+	}
+	tb.Cleanup(func() { _ = client.Close() })
+	return client
+}
+
+func TestStopProbingWhileReentered(t *testing.T) {
+	c := newTestClient(t, http.NotFoundHandler(), ClientOptions{})
+
+	// A request re-entered maintenance after the probe's status check
+	c.maintenance.active = true
+	c.maintenance.probing = true
+	if c.stopProbing() {
+		t.Fatal("probe stopped although the client is in maintenance")
+	}
+	if !c.maintenance.probing {
+		t.Fatal("probing flag cleared while in maintenance")
+	}
+
+	c.exitMaintenance()
+	if !c.stopProbing() {
+		t.Fatal("probe kept running after maintenance ended")
+	}
+	if c.maintenance.probing {
+		t.Fatal("probing flag still set")
+	}
+}
+
+func TestProbeMaintenanceReentry(t *testing.T) {
+	healthy := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"status":"ok","isMaintenance":false}`))
+	})
+	c := newTestClient(t, healthy, ClientOptions{MaintenanceProbeInterval: time.Millisecond})
+
+	// Re-enter maintenance while probes keep clearing it, so entries land
+	// between the probe's exit and its shutdown.
+	var wg sync.WaitGroup
+	for range 4 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			deadline := time.Now().Add(200 * time.Millisecond)
+			for time.Now().Before(deadline) {
+				c.enterMaintenance()
+			}
+		}()
+	}
+	wg.Wait()
+	c.enterMaintenance()
+
+	deadline := time.Now().Add(2 * time.Second)
+	for {
+		active, _ := c.InMaintenance()
+		if !active {
+			return
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("client stuck in maintenance after the API recovered")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+func TestEnterMaintenanceReporterReentry(t *testing.T) {
+	var c *Client
+	done := make(chan struct{})
+	reporter := StatsReporterFunc(func(name string, delta int64) {
+		if name == CounterMaintenance {
+			c.InMaintenance()
+			c.Healthy()
+		}
+	})
+	c = newTestClient(t, http.NotFoundHandler(), ClientOptions{
+		StatsReporter:            reporter,
+		MaintenanceProbeInterval: time.Hour,
+	})
+
+	go func() {
+		c.enterMaintenance()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(2 * time.Second):
+		t.Fatal("enterMaintenance deadlocked on a reporter calling InMaintenance")
+	}
+}
@@ -0,0 +1,162 @@
+package nobitex
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+
+	t "github.com/darhelm/go-nobitex/types"
+)
+
+// quoteCurrencies maps market symbol suffixes to Nobitex quote currencies.
+var quoteCurrencies = []struct{ suffix, currency string }{
+	{"USDT", "usdt"},
+	{"IRT", "rls"},
+	{"RLS", "rls"},
+}
+
+// splitMarketSymbol splits a market symbol such as "BTCIRT" into its base
+// and quote currencies ("btc", "rls").
+func splitMarketSymbol(symbol string) (string, string, error) {
+	symbol = strings.ToUpper(symbol)
+	for _, quote := range quoteCurrencies {
+		if base, ok := strings.CutSuffix(symbol, quote.suffix); ok && base != "" {
+			return strings.ToLower(base), quote.currency, nil
+		}
+	}
+	return "", "", &GoNobitexError{Message: fmt.Sprintf("unrecognized market symbol %q", symbol)}
+}
+
+// QuoteMarketOrder builds a market order worth quoteAmount of the quote
+// currency, e.g. "5000000" IRT of BTC, by walking the current order book.
+//
+// Parameters:
+//   - ctx: Context of the order book and configuration requests.
+//   - side: "buy" or "sell".
+//   - symbol: Market symbol such as "BTCIRT" or "ETHUSDT".
+//   - quoteAmount: Quote value to spend (buy) or receive (sell), as a
+//     decimal string in the market's quote unit (IRT markets are priced in
+//     rials).
+//
+// Returns:
+//   - CreateOrderParams with Amount rounded down to the market's amount
+//     precision, ready for CreateOrder.
+//   - An error if the book cannot cover quoteAmount or the amount rounds
+//     to zero.
+//
+// Behavior:
+//   - Levels are consumed best-first, so the amount accounts for the
+//     book's depth; the actual fill may still differ if the book moves.
+func (c *Client) QuoteMarketOrder(ctx context.Context, side string, symbol string, quoteAmount string, opts ...RequestOption) (t.CreateOrderParams, error) {
+	if side != "buy" && side != "sell" {
+		return t.CreateOrderParams{}, &GoNobitexError{Message: fmt.Sprintf("invalid order type %q", side)}
+	}
+	src, dst, err := splitMarketSymbol(symbol)
+	if err != nil {
+		return t.CreateOrderParams{}, err
+	}
+	budget, err := parseDecimal("quote amount", quoteAmount)
+	if err != nil {
+		return t.CreateOrderParams{}, err
+	}
+	if budget.Sign() <= 0 {
+		return t.CreateOrderParams{}, &GoNobitexError{Message: fmt.Sprintf("quote amount must be positive, got %q", quoteAmount)}
+	}
+
+	opts = append([]RequestOption{WithContext(ctx)}, opts...)
+	book, err := c.GetOrderBook(MarketSymbol(src, dst), opts...)
+	if err != nil {
+		return t.CreateOrderParams{}, err
+	}
+
+	levels := book.Asks
+	if side == "sell" {
+		levels = book.Bids
+	}
+	amount, err := amountForQuote(levels, budget)
+	if err != nil {
+		return t.CreateOrderParams{}, err
+	}
+
+	config, err := c.Config(ctx)
+	if err != nil {
+		return t.CreateOrderParams{}, err
+	}
+	amount = FloorToStep(amount, marketAmountStep(&config.Nobitex, MarketSymbol(src, dst), src).Step)
+	if amount.Sign() <= 0 {
+		return t.CreateOrderParams{}, &GoNobitexError{
+			Message: fmt.Sprintf("quote amount %s is below one %s amount step", quoteAmount, src),
+		}
+	}
+
+	return t.CreateOrderParams{
+		Execution:   "market",
+		Type:        side,
+		SrcCurrency: src,
+		DstCurrency: dst,
+		Amount:      FormatDecimal(amount),
+	}, nil
+}
+
+// amountForQuote returns the base amount whose value across levels
+// equals quote.
+func amountForQuote(levels [][]string, quote *big.Rat) (*big.Rat, error) {
+	remaining := new(big.Rat).Set(quote)
+	amount := new(big.Rat)
+
+	for _, level := range levels {
+		if len(level) < 2 {
+			continue
+		}
+		price, err := parseDecimal("book price", level[0])
+		if err != nil {
+			return nil, err
+		}
+		size, err := parseDecimal("book size", level[1])
+		if err != nil {
+			return nil, err
+		}
+		if price.Sign() <= 0 {
+			continue
+		}
+
+		value := new(big.Rat).Mul(price, size)
+		if value.Cmp(remaining) >= 0 {
+			amount.Add(amount, new(big.Rat).Quo(remaining, price))
+			return amount, nil
+		}
+		amount.Add(amount, size)
+		remaining.Sub(remaining, value)
+	}
+
+	return nil, &GoNobitexError{
+		Message: fmt.Sprintf("order book too thin for quote amount %s", quote.FloatString(0)),
+	}
+}
+
+// MarketBuy places a market buy spending about quoteAmount of the quote
+// currency. See QuoteMarketOrder for how the base amount is derived.
+//
+// Example:
+//
+//	// Buy 5,000,000 IRT (50,000,000 rials) worth of BTC
+//	order, err := client.MarketBuy(ctx, "BTCIRT", "50000000")
+func (c *Client) MarketBuy(ctx context.Context, symbol string, quoteAmount string, opts ...RequestOption) (*t.OrderStatus, error) {
+	return c.quoteMarketOrder(ctx, "buy", symbol, quoteAmount, opts)
+}
+
+// MarketSell places a market sell receiving about quoteAmount of the quote
+// currency, before fees. See QuoteMarketOrder.
+func (c *Client) MarketSell(ctx context.Context, symbol string, quoteAmount string, opts ...RequestOption) (*t.OrderStatus, error) {
+	return c.quoteMarketOrder(ctx, "sell", symbol, quoteAmount, opts)
+}
+
+// quoteMarketOrder builds and places a quote-denominated market order.
+func (c *Client) quoteMarketOrder(ctx context.Context, side string, symbol string, quoteAmount string, opts []RequestOption) (*t.OrderStatus, error) {
+	params, err := c.QuoteMarketOrder(ctx, side, symbol, quoteAmount, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return c.CreateOrder(params, append([]RequestOption{WithContext(ctx)}, opts...)...)
+}
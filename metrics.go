@@ -0,0 +1,180 @@
+package nobitex
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptrace"
+	"sync/atomic"
+	"time"
+)
+
+// MetricsHook groups optional callbacks through which the client reports
+// runtime metrics to the application (Prometheus, StatsD, logs, ...).
+// Any nil callback is skipped.
+type MetricsHook struct {
+	// OnConnection is invoked every time a request obtains a connection,
+	// reporting whether it was freshly dialed or reused from the pool.
+	OnConnection func(info ConnInfo)
+
+	// OnRequest is invoked after every HTTP exchange with the API,
+	// including each retry, with its endpoint, status and latency.
+	OnRequest func(info RequestInfo)
+
+	// OnSlowRequest is invoked, in addition to OnRequest, for every
+	// exchange slower than ClientOptions.SlowRequestThreshold.
+	OnSlowRequest func(info RequestInfo)
+
+	// OnAuthRefresh is invoked after every automatic re-authentication
+	// attempt (see ClientOptions.AutoRefresh), successful or not. The
+	// refresh runs inline before the request that triggered it, so its
+	// Duration adds to that request's latency.
+	OnAuthRefresh func(info AuthRefreshInfo)
+}
+
+// AuthRefreshReason tells why the client re-authenticated.
+type AuthRefreshReason string
+
+const (
+	// AuthRefreshExpired means the API key outlived the session lifetime
+	// implied by Remember (4 hours, or 30 days with RememberYes).
+	AuthRefreshExpired AuthRefreshReason = "ttl_expired"
+)
+
+// AuthRefreshInfo describes one automatic re-authentication.
+type AuthRefreshInfo struct {
+	// Reason is what triggered the refresh.
+	Reason AuthRefreshReason
+
+	// KeyAge is how old the replaced API key was.
+	KeyAge time.Duration
+
+	// Started is when the refresh began.
+	Started time.Time
+
+	// Duration is how long the login took.
+	Duration time.Duration
+
+	// Err is the refresh error; nil means a new API key is in use.
+	Err error
+}
+
+// reportAuthRefresh forwards info to the metrics hook and, in debug mode,
+// to the debug writer.
+func (c *Client) reportAuthRefresh(info AuthRefreshInfo) {
+	if c.debug {
+		outcome := "ok"
+		if info.Err != nil {
+			outcome = info.Err.Error()
+		}
+		c.writeDebug(fmt.Sprintf("auth refresh (%s, key age %s) took %s: %s",
+			info.Reason, info.KeyAge.Round(time.Second), info.Duration.Round(time.Millisecond), outcome))
+	}
+
+	if c.metrics.OnAuthRefresh != nil {
+		safeHook("metrics hook", c.onPanic, func() {
+			c.metrics.OnAuthRefresh(info)
+		})
+	}
+}
+
+// ConnInfo describes the connection used for a single request.
+type ConnInfo struct {
+	// Host is the host:port the request was sent to.
+	Host string
+
+	// Reused reports whether the connection came from the idle pool.
+	// A false value means a new TCP + TLS handshake was performed.
+	Reused bool
+
+	// WasIdle reports whether the reused connection had been idle.
+	WasIdle bool
+
+	// IdleTime is how long the connection had been idle, if WasIdle.
+	IdleTime time.Duration
+}
+
+// ConnStats is a snapshot of the client's connection usage counters.
+type ConnStats struct {
+	// NewConns counts requests that had to open a new connection.
+	NewConns uint64
+
+	// ReusedConns counts requests served over a pooled connection.
+	ReusedConns uint64
+
+	// HTTP2Responses counts responses received over HTTP/2.
+	HTTP2Responses uint64
+
+	// HTTP1Responses counts responses received over HTTP/1.x.
+	HTTP1Responses uint64
+}
+
+// connCounters holds the live counters behind ConnStats.
+type connCounters struct {
+	newConns    atomic.Uint64
+	reusedConns atomic.Uint64
+	http2       atomic.Uint64
+	http1       atomic.Uint64
+}
+
+// ConnStats returns the connection reuse and protocol counters accumulated
+// since the client was created. A healthy long-running client shows
+// ReusedConns growing much faster than NewConns.
+func (c *Client) ConnStats() ConnStats {
+	return ConnStats{
+		NewConns:       c.connCounters.newConns.Load(),
+		ReusedConns:    c.connCounters.reusedConns.Load(),
+		HTTP2Responses: c.connCounters.http2.Load(),
+		HTTP1Responses: c.connCounters.http1.Load(),
+	}
+}
+
+// traceConnections attaches an httptrace.ClientTrace to req that updates the
+// connection counters and forwards ConnInfo to the metrics hook.
+func (c *Client) traceConnections(req *http.Request) *http.Request {
+	trace := &httptrace.ClientTrace{
+		GotConn: func(info httptrace.GotConnInfo) {
+			if info.Reused {
+				c.connCounters.reusedConns.Add(1)
+			} else {
+				c.connCounters.newConns.Add(1)
+			}
+
+			if c.metrics.OnConnection != nil {
+				safeHook("metrics hook", c.onPanic, func() {
+					c.metrics.OnConnection(ConnInfo{
+						Host:     req.URL.Host,
+						Reused:   info.Reused,
+						WasIdle:  info.WasIdle,
+						IdleTime: info.IdleTime,
+					})
+				})
+			}
+		},
+	}
+
+	return req.WithContext(httptrace.WithClientTrace(req.Context(), trace))
+}
+
+// recordProtocol counts the protocol of a received response.
+func (c *Client) recordProtocol(resp *http.Response) {
+	if resp.ProtoMajor == 2 {
+		c.connCounters.http2.Add(1)
+	} else {
+		c.connCounters.http1.Add(1)
+	}
+}
+
+// newTransport returns the transport used when no custom HttpClient is
+// supplied. It is tuned for a single API host: HTTP/2 is attempted on every
+// TLS connection and enough idle connections are kept to avoid repeated
+// handshakes with apiv2.nobitex.ir. headerTimeout bounds the wait for
+// response headers without limiting how long a body may stream.
+func newTransport(headerTimeout time.Duration) *http.Transport {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.ForceAttemptHTTP2 = true
+	transport.MaxIdleConns = 100
+	transport.MaxIdleConnsPerHost = 32
+	transport.IdleConnTimeout = 90 * time.Second
+	transport.ResponseHeaderTimeout = headerTimeout
+	return transport
+}
@@ -0,0 +1,265 @@
+package nobitex
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+
+	t "github.com/darhelm/go-nobitex/types"
+)
+
+// MultiClient fans calls out over several authenticated clients, one per
+// Nobitex account, and aggregates their results.
+//
+// Example:
+//
+//	alice, _ := base.Clone(nobitex.Credentials{ApiKey: aliceKey})
+//	bob, _ := base.Clone(nobitex.Credentials{ApiKey: bobKey})
+//	mc, err := nobitex.NewMultiClient(map[string]*nobitex.Client{
+//	    "alice": alice,
+//	    "bob":   bob,
+//	}, nobitex.RateLimit{Requests: 30, Per: time.Minute})
+//	if err != nil {
+//	    return err
+//	}
+//	balances, err := mc.Balances(ctx)
+type MultiClient struct {
+	names    []string
+	accounts map[string]*multiAccount
+}
+
+// multiAccount is one account of a MultiClient.
+type multiAccount struct {
+	client *Client
+
+	// orders limits order placement of this account; nil means unlimited.
+	orders *tokenBucket
+}
+
+// AccountResult is the outcome of one account's part of a fan-out call.
+type AccountResult struct {
+	// Account is the account name.
+	Account string
+
+	// Order is the created order, nil on failure.
+	Order *t.OrderStatus
+
+	// Err is the account's error, if any.
+	Err error
+}
+
+// AccountError wraps the error of one account of a MultiClient call.
+type AccountError struct {
+	// Account is the account name.
+	Account string
+
+	// Err is the underlying error.
+	Err error
+}
+
+// Error implements the error interface.
+func (e *AccountError) Error() string {
+	return fmt.Sprintf("account %s: %v", e.Account, e.Err)
+}
+
+// Unwrap returns the underlying error.
+func (e *AccountError) Unwrap() error {
+	return e.Err
+}
+
+// NewMultiClient groups accounts, keyed by a name of the caller's choice.
+// orderLimit, if valid, gives every account its own order placement
+// budget, independent of the clients' ClientOptions.RateLimits (which
+// Clone shares between accounts).
+func NewMultiClient(accounts map[string]*Client, orderLimit RateLimit) (*MultiClient, error) {
+	if len(accounts) == 0 {
+		return nil, &GoNobitexError{Message: "no accounts given"}
+	}
+
+	mc := &MultiClient{accounts: make(map[string]*multiAccount, len(accounts))}
+	for name, client := range accounts {
+		if client == nil {
+			return nil, &GoNobitexError{Message: fmt.Sprintf("account %s has no client", name)}
+		}
+
+		account := &multiAccount{client: client}
+		if orderLimit.Requests > 0 && orderLimit.Per > 0 {
+			account.orders = newTokenBucket(orderLimit)
+		}
+		mc.accounts[name] = account
+		mc.names = append(mc.names, name)
+	}
+	sort.Strings(mc.names)
+	return mc, nil
+}
+
+// Accounts returns the account names, sorted.
+func (mc *MultiClient) Accounts() []string {
+	return append([]string(nil), mc.names...)
+}
+
+// Client returns the client of one account.
+func (mc *MultiClient) Client(name string) (*Client, bool) {
+	account, ok := mc.accounts[name]
+	if !ok {
+		return nil, false
+	}
+	return account.client, true
+}
+
+// Balances returns every non-empty balance summed over all accounts,
+// sorted by currency.
+//
+// Behavior:
+//   - Accounts are queried concurrently.
+//   - Accounts that fail are left out; their errors are returned joined,
+//     as *AccountError, together with the balances of the others.
+func (mc *MultiClient) Balances(ctx context.Context, opts ...RequestOption) ([]t.AggregatedBalance, error) {
+	opts = append([]RequestOption{WithContext(ctx)}, opts...)
+
+	var (
+		mu       sync.Mutex
+		errs     []error
+		balances = make(map[string]*t.AggregatedBalance)
+	)
+	mc.each(mc.names, func(name string, account *multiAccount) {
+		wallets, err := account.client.GetWallets(t.GetWalletParams{}, opts...)
+		if err != nil {
+			mu.Lock()
+			errs = append(errs, &AccountError{Account: name, Err: err})
+			mu.Unlock()
+			return
+		}
+
+		parsed := make([]*t.Balance, 0, len(wallets.Wallets))
+		for currency, wallet := range wallets.Wallets {
+			balance, err := parseBalance(strings.ToLower(currency), wallet)
+			if err != nil {
+				mu.Lock()
+				errs = append(errs, &AccountError{Account: name, Err: err})
+				mu.Unlock()
+				return
+			}
+			if balance.Total.Sign() > 0 {
+				parsed = append(parsed, balance)
+			}
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+		for _, balance := range parsed {
+			agg, ok := balances[balance.Currency]
+			if !ok {
+				agg = &t.AggregatedBalance{
+					Currency:  balance.Currency,
+					Total:     new(big.Rat),
+					Blocked:   new(big.Rat),
+					Available: new(big.Rat),
+					Accounts:  make(map[string]*t.Balance),
+				}
+				balances[balance.Currency] = agg
+			}
+			agg.Total.Add(agg.Total, balance.Total)
+			agg.Blocked.Add(agg.Blocked, balance.Blocked)
+			agg.Available.Add(agg.Available, balance.Available)
+			agg.Accounts[name] = balance
+		}
+	})
+
+	result := make([]t.AggregatedBalance, 0, len(balances))
+	for _, agg := range balances {
+		result = append(result, *agg)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Currency < result[j].Currency })
+	return result, errors.Join(errs...)
+}
+
+// OpenOrders returns the open orders summary of every account, keyed by
+// account name. Failed accounts are left out and their errors returned
+// joined, as *AccountError.
+func (mc *MultiClient) OpenOrders(ctx context.Context, params t.GetOrdersListParams) (map[string]*t.OpenOrdersSummary, error) {
+	var (
+		mu        sync.Mutex
+		errs      []error
+		summaries = make(map[string]*t.OpenOrdersSummary)
+	)
+	mc.each(mc.names, func(name string, account *multiAccount) {
+		summary, err := account.client.GetOpenOrdersSummary(ctx, params)
+
+		mu.Lock()
+		defer mu.Unlock()
+		if err != nil {
+			errs = append(errs, &AccountError{Account: name, Err: err})
+			return
+		}
+		summaries[name] = summary
+	})
+	return summaries, errors.Join(errs...)
+}
+
+// CreateOrder places the same order on each named account, or on every
+// account when names is empty, and returns one result per account in
+// name order. Duplicate names are placed once.
+//
+// Behavior:
+//   - Accounts are served concurrently; each waits for its own order
+//     budget (see NewMultiClient) or ctx.
+//   - Unknown account names yield a result with an error.
+//   - One account failing does not affect the others.
+func (mc *MultiClient) CreateOrder(ctx context.Context, params t.CreateOrderParams, names ...string) []AccountResult {
+	if len(names) == 0 {
+		names = mc.names
+	}
+	names = slices.Compact(slices.Sorted(slices.Values(names)))
+
+	results := make([]AccountResult, len(names))
+	index := make(map[string]int, len(names))
+	for i, name := range names {
+		results[i].Account = name
+		index[name] = i
+		if _, ok := mc.accounts[name]; !ok {
+			results[i].Err = &AccountError{Account: name, Err: &GoNobitexError{Message: "unknown account"}}
+		}
+	}
+
+	mc.each(names, func(name string, account *multiAccount) {
+		result := &results[index[name]]
+		if account.orders != nil {
+			if err := account.orders.wait(ctx); err != nil {
+				result.Err = &AccountError{Account: name, Err: err}
+				return
+			}
+		}
+
+		order, err := account.client.CreateOrder(params, WithContext(ctx))
+		if err != nil {
+			result.Err = &AccountError{Account: name, Err: err}
+			return
+		}
+		result.Order = order
+	})
+	return results
+}
+
+// each runs fn concurrently for every known account in names and waits
+// for all of them.
+func (mc *MultiClient) each(names []string, fn func(name string, account *multiAccount)) {
+	var wg sync.WaitGroup
+	for _, name := range names {
+		account, ok := mc.accounts[name]
+		if !ok {
+			continue
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			fn(name, account)
+		}()
+	}
+	wg.Wait()
+}
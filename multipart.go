@@ -0,0 +1,142 @@
+package nobitex
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/textproto"
+	"sort"
+)
+
+// MultipartBody is a request body sent as multipart/form-data instead of
+// JSON. Pass it (or a pointer to it) as the body argument of Request or
+// ApiRequest for document-upload endpoints such as verification
+// attachments.
+//
+// Example:
+//
+//	f, _ := os.Open("id-card.jpg")
+//	defer f.Close()
+//
+//	body := &nobitex.MultipartBody{
+//	    Fields: map[string]string{"tp": "nationalCard"},
+//	    Files: []nobitex.MultipartFile{
+//	        {FieldName: "file", FileName: "id-card.jpg", ContentType: "image/jpeg", Content: f},
+//	    },
+//	}
+//	err := client.ApiRequest("POST", "/users/upload-file", "", true, false, body, &res)
+type MultipartBody struct {
+	// Fields holds plain form values.
+	Fields map[string]string
+
+	// Files holds the file parts, written in order after the fields.
+	Files []MultipartFile
+}
+
+// MultipartFile is a single file part of a MultipartBody.
+type MultipartFile struct {
+	// FieldName is the form field the file is attached to.
+	FieldName string
+
+	// FileName is the file name reported to the server.
+	FileName string
+
+	// ContentType is the part's MIME type. Defaults to
+	// "application/octet-stream" when empty.
+	ContentType string
+
+	// Content supplies the file data. It is read once, when the request
+	// body is encoded; retries and failover resend the encoded bytes.
+	Content io.Reader
+}
+
+// encodedMultipart is a MultipartBody rendered once, so every attempt of
+// a retried or failed-over call sends the same bytes instead of reading
+// already consumed file readers again.
+type encodedMultipart struct {
+	data        []byte
+	contentType string
+}
+
+// encodeMultipart renders body ahead of the attempts when it is a
+// MultipartBody and returns it unchanged otherwise.
+func encodeMultipart(body interface{}) (interface{}, error) {
+	multipartBody, ok := asMultipart(body)
+	if !ok {
+		return body, nil
+	}
+
+	data, contentType, err := multipartBody.encode()
+	if err != nil {
+		return nil, &RequestError{
+			GoNobitexError: GoNobitexError{
+				Message: "failed to encode multipart body",
+				Err:     err,
+			},
+			Operation: "preparing request body",
+		}
+	}
+	return &encodedMultipart{data: data, contentType: contentType}, nil
+}
+
+// asMultipart reports whether body is a MultipartBody value or pointer.
+func asMultipart(body interface{}) (*MultipartBody, bool) {
+	switch b := body.(type) {
+	case *MultipartBody:
+		return b, b != nil
+	case MultipartBody:
+		return &b, true
+	default:
+		return nil, false
+	}
+}
+
+// encode renders the body and returns it together with the Content-Type
+// header value (including the multipart boundary).
+func (m *MultipartBody) encode() ([]byte, string, error) {
+	var buf bytes.Buffer
+	writer := multipart.NewWriter(&buf)
+
+	// Sort field names so the encoded body is deterministic
+	keys := make([]string, 0, len(m.Fields))
+	for key := range m.Fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		if err := writer.WriteField(key, m.Fields[key]); err != nil {
+			return nil, "", err
+		}
+	}
+
+	for _, file := range m.Files {
+		if file.Content == nil {
+			return nil, "", fmt.Errorf("multipart file %q has no content", file.FieldName)
+		}
+
+		contentType := file.ContentType
+		if contentType == "" {
+			contentType = "application/octet-stream"
+		}
+
+		header := make(textproto.MIMEHeader)
+		header.Set("Content-Disposition", fmt.Sprintf(`form-data; name=%q; filename=%q`, file.FieldName, file.FileName))
+		header.Set("Content-Type", contentType)
+
+		part, err := writer.CreatePart(header)
+		if err != nil {
+			return nil, "", err
+		}
+		if _, err := io.Copy(part, file.Content); err != nil {
+			return nil, "", err
+		}
+	}
+
+	if err := writer.Close(); err != nil {
+		return nil, "", err
+	}
+
+	return buf.Bytes(), writer.FormDataContentType(), nil
+}
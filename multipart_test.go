@@ -0,0 +1,50 @@
+package nobitex
+
+import (
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestMultipartBodySurvivesFailover(t *testing.T) {
+	var received string
+	mirror := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		file, _, err := r.FormFile("file")
+		if err != nil {
+			t.Errorf("FormFile: %v", err)
+			return
+		}
+		data, _ := io.ReadAll(file)
+		received = string(data)
+		_, _ = w.Write([]byte(`{"status":"ok"}`))
+	}))
+	defer mirror.Close()
+
+	// A listener closed right away gives a base URL that refuses to dial
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	dead := "http://" + ln.Addr().String()
+	_ = ln.Close()
+
+	c, err := NewClient(ClientOptions{BaseUrl: dead, BaseUrls: []string{mirror.URL}, ApiKey: "test-key"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+
+	body := &MultipartBody{Files: []MultipartFile{
+		{FieldName: "file", FileName: "doc.txt", Content: strings.NewReader("document")},
+	}}
+	var out map[string]any
+	if err := c.ApiRequest("POST", "/users/upload-file", "", true, false, body, &out); err != nil {
+		t.Fatalf("ApiRequest: %v", err)
+	}
+	if received != "document" {
+		t.Fatalf("mirror received %q, want %q", received, "document")
+	}
+}
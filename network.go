@@ -0,0 +1,63 @@
+package nobitex
+
+import (
+	"context"
+	"fmt"
+
+	t "github.com/darhelm/go-nobitex/types"
+)
+
+// TransferDirection selects which side of a network's settings
+// ResolveNetwork checks.
+type TransferDirection int
+
+const (
+	DirectionDeposit    TransferDirection = iota // receiving funds
+	DirectionWithdrawal                          // sending funds
+)
+
+// ResolveNetwork validates network for a transfer of currency against the
+// published currency metadata and returns its settings. The SDK does not
+// wrap the deposit-address and withdrawal endpoints; call it before
+// sending those requests through ApiRequest.
+//
+// Parameters:
+//   - ctx: Used when the cached configuration must be fetched.
+//   - currency: Asset symbol such as "usdt".
+//   - network: Network name; token-standard spellings ("TRC20") are
+//     accepted. Empty selects the currency's default network.
+//   - direction: DirectionDeposit or DirectionWithdrawal.
+//
+// Returns:
+//   - The t.NetworkInfo of the resolved network.
+//   - A GoNobitexError if the currency has no such network, no default
+//     network, or transfers in direction are disabled on it.
+func (c *Client) ResolveNetwork(ctx context.Context, currency string, network t.Network, direction TransferDirection) (t.NetworkInfo, error) {
+	info, err := c.Currency(ctx, currency)
+	if err != nil {
+		return t.NetworkInfo{}, err
+	}
+
+	var settings t.NetworkInfo
+	var ok bool
+	if network == "" {
+		settings, ok = info.DefaultNetwork()
+		if !ok {
+			return t.NetworkInfo{}, &GoNobitexError{Message: fmt.Sprintf("%s has no default network; set one explicitly", currency)}
+		}
+	} else if settings, ok = info.Network(network); !ok {
+		return t.NetworkInfo{}, &GoNobitexError{Message: fmt.Sprintf("%s is not available on network %q", currency, network)}
+	}
+
+	switch direction {
+	case DirectionDeposit:
+		if !settings.DepositEnable {
+			return t.NetworkInfo{}, &GoNobitexError{Message: fmt.Sprintf("%s deposits on %s are disabled", currency, settings.Network)}
+		}
+	case DirectionWithdrawal:
+		if !settings.WithdrawEnable {
+			return t.NetworkInfo{}, &GoNobitexError{Message: fmt.Sprintf("%s withdrawals on %s are disabled", currency, settings.Network)}
+		}
+	}
+	return settings, nil
+}
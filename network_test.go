@@ -0,0 +1,59 @@
+package nobitex
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/darhelm/go-nobitex/types"
+)
+
+func TestResolveNetwork(t *testing.T) {
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"status":"ok","nobitex":{},"coins":[
+			{"coin":"usdt","networkList":{
+				"TRX":{"network":"TRX","isDefault":true,"depositEnable":true,"withdrawEnable":false},
+				"ETH":{"network":"ETH","depositEnable":false,"withdrawEnable":true}
+			}},
+			{"coin":"xyz","networkList":{
+				"A":{"network":"A","depositEnable":true,"withdrawEnable":true},
+				"B":{"network":"B","depositEnable":true,"withdrawEnable":true}
+			}}
+		]}`))
+	}), ClientOptions{})
+
+	tests := []struct {
+		name      string
+		currency  string
+		network   types.Network
+		direction TransferDirection
+		want      types.Network
+		err       string
+	}{
+		{"default network", "usdt", "", DirectionDeposit, types.NetworkTRX, ""},
+		{"token-standard alias", "usdt", "ERC20", DirectionWithdrawal, types.NetworkETH, ""},
+		{"no default network", "xyz", "", DirectionDeposit, "", "no default network"},
+		{"unknown network", "usdt", types.NetworkBSC, DirectionDeposit, "", "not available on network"},
+		{"deposits disabled", "usdt", types.NetworkETH, DirectionDeposit, "", "deposits on ETH are disabled"},
+		{"withdrawals disabled", "usdt", "", DirectionWithdrawal, "", "withdrawals on TRX are disabled"},
+		{"unknown currency", "abc", "", DirectionDeposit, "", "no metadata published"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := c.ResolveNetwork(context.Background(), tt.currency, tt.network, tt.direction)
+			if tt.err != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.err) {
+					t.Fatalf("err = %v, want one mentioning %q", err, tt.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got.Network != tt.want {
+				t.Fatalf("network = %q, want %q", got.Network, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,79 @@
+package notify
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+)
+
+// Event is a notification produced by the SDK's alerting and automation
+// subsystems.
+type Event struct {
+	// Kind is a dotted event name, e.g. "order.filled", "alert.triggered"
+	// or "dca.placed".
+	Kind string `json:"kind"`
+
+	// Subject is a one-line summary suitable for a chat message title or
+	// e-mail subject.
+	Subject string `json:"subject"`
+
+	// Message is a human-readable description.
+	Message string `json:"message,omitempty"`
+
+	// Data is the structured payload, e.g. an OrderPayload.
+	Data any `json:"data,omitempty"`
+
+	// At is when the event happened.
+	At time.Time `json:"at"`
+}
+
+// Notifier delivers events to a destination. Implementations route them
+// to Telegram, e-mail, Slack or anything else by implementing Notify.
+type Notifier interface {
+	Notify(ctx context.Context, event Event) error
+}
+
+// NotifierFunc adapts a function to the Notifier interface.
+type NotifierFunc func(ctx context.Context, event Event) error
+
+// Notify calls f.
+func (f NotifierFunc) Notify(ctx context.Context, event Event) error {
+	return f(ctx, event)
+}
+
+// Multi delivers every event to each of its notifiers, joining their
+// errors.
+type Multi []Notifier
+
+// Notify fans event out to every notifier.
+func (m Multi) Notify(ctx context.Context, event Event) error {
+	var errs []error
+	for _, n := range m {
+		if err := n.Notify(ctx, event); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Log writes one line per event to Logger.
+type Log struct {
+	// Logger receives the lines. Defaults to log.Default().
+	Logger *log.Logger
+}
+
+// Notify logs event as "[kind] subject: message".
+func (l Log) Notify(_ context.Context, event Event) error {
+	logger := l.Logger
+	if logger == nil {
+		logger = log.Default()
+	}
+
+	if event.Message == "" {
+		logger.Printf("[%s] %s", event.Kind, event.Subject)
+	} else {
+		logger.Printf("[%s] %s: %s", event.Kind, event.Subject, event.Message)
+	}
+	return nil
+}
@@ -0,0 +1,149 @@
+package notify
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	nobitex "github.com/darhelm/go-nobitex"
+	t "github.com/darhelm/go-nobitex/types"
+)
+
+// Defaults used by ForwardOrderEvents.
+const (
+	DefaultOrderPollInterval = 2 * time.Second
+	DefaultOrderBufferSize   = 64
+)
+
+// OrderSource publishes order events. *nobitex.Client implements it.
+type OrderSource interface {
+	Events() *nobitex.EventBus
+	WatchOrders(ctx context.Context, interval time.Duration) error
+}
+
+// OrderForwardOptions configures ForwardOrderEvents.
+type OrderForwardOptions struct {
+	// Types selects the forwarded events. Defaults to fills, partial
+	// fills, cancellations and rejections.
+	Types []nobitex.OrderEventType
+
+	// PollInterval is passed to WatchOrders. Defaults to
+	// DefaultOrderPollInterval.
+	PollInterval time.Duration
+
+	// BufferSize bounds events queued while a delivery is in progress;
+	// further events are dropped. Defaults to DefaultOrderBufferSize.
+	BufferSize int
+
+	// OnError, if set, receives failed deliveries.
+	OnError func(err error)
+}
+
+// OrderPayload is the Data of an order event.
+type OrderPayload struct {
+	Type          nobitex.OrderEventType `json:"type"`
+	OrderId       int                    `json:"orderId,omitempty"`
+	ClientOrderId string                 `json:"clientOrderId,omitempty"`
+	Order         *t.OrderStatusResponse `json:"order,omitempty"`
+	Error         string                 `json:"error,omitempty"`
+	At            time.Time              `json:"at"`
+}
+
+// defaultOrderTypes are forwarded when no Types are configured.
+var defaultOrderTypes = []nobitex.OrderEventType{
+	nobitex.OrderPartiallyFilled,
+	nobitex.OrderFilled,
+	nobitex.OrderCanceled,
+	nobitex.OrderRejected,
+}
+
+// ForwardOrderEvents polls the orders placed through source and delivers
+// every selected event to n as "order.<type>", until ctx is done or the
+// client is closed.
+//
+// Example:
+//
+//	hook := &notify.Webhook{URL: "https://example.com/hooks/nobitex", Secret: secret}
+//	go notify.ForwardOrderEvents(ctx, client, hook, notify.OrderForwardOptions{
+//	    OnError: func(err error) { log.Println(err) },
+//	})
+func ForwardOrderEvents(ctx context.Context, source OrderSource, n Notifier, opts OrderForwardOptions) error {
+	types := opts.Types
+	if len(types) == 0 {
+		types = defaultOrderTypes
+	}
+	interval := opts.PollInterval
+	if interval <= 0 {
+		interval = DefaultOrderPollInterval
+	}
+	size := opts.BufferSize
+	if size <= 0 {
+		size = DefaultOrderBufferSize
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	events := source.Events().Channel(ctx, size)
+
+	watchErr := make(chan error, 1)
+	go func() {
+		watchErr <- source.WatchOrders(ctx, interval)
+		cancel()
+	}()
+
+	for ev := range events {
+		if !slices.Contains(types, ev.Type) {
+			continue
+		}
+		if err := n.Notify(ctx, OrderEvent(ev)); err != nil && opts.OnError != nil {
+			opts.OnError(err)
+		}
+	}
+	return <-watchErr
+}
+
+// OrderEvent converts an order lifecycle event into a notification.
+func OrderEvent(ev nobitex.OrderEvent) Event {
+	subject := fmt.Sprintf("order %d %s", ev.OrderId, strings.ReplaceAll(string(ev.Type), "_", " "))
+	if ev.OrderId == 0 {
+		subject = fmt.Sprintf("order %s %s", ev.ClientOrderId, strings.ReplaceAll(string(ev.Type), "_", " "))
+	}
+
+	var message string
+	if ev.Order.Id != 0 {
+		message = fmt.Sprintf("%s %s %s/%s @ %s", ev.Order.Type, ev.Order.Amount,
+			ev.Order.SrcCurrency, ev.Order.DstCurrency, ev.Order.Price)
+	}
+	if ev.Err != nil {
+		message = ev.Err.Error()
+	}
+
+	return Event{
+		Kind:    "order." + string(ev.Type),
+		Subject: subject,
+		Message: message,
+		Data:    newOrderPayload(ev),
+		At:      ev.At,
+	}
+}
+
+// newOrderPayload converts an event into its JSON form.
+func newOrderPayload(ev nobitex.OrderEvent) OrderPayload {
+	payload := OrderPayload{
+		Type:          ev.Type,
+		OrderId:       ev.OrderId,
+		ClientOrderId: ev.ClientOrderId,
+		At:            ev.At,
+	}
+	if ev.Order.Id != 0 {
+		order := ev.Order
+		payload.Order = &order
+	}
+	if ev.Err != nil {
+		payload.Error = ev.Err.Error()
+	}
+	return payload
+}
@@ -0,0 +1,159 @@
+// Package notify delivers trading events to external systems.
+package notify
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Headers set on every webhook request.
+const (
+	// HeaderEvent carries the event name, e.g. "order.filled".
+	HeaderEvent = "X-Webhook-Event"
+
+	// HeaderTimestamp carries the unix time the request was signed at.
+	HeaderTimestamp = "X-Webhook-Timestamp"
+
+	// HeaderSignature carries "sha256=" followed by the hex HMAC-SHA256 of
+	// "<timestamp>.<body>" keyed with the shared secret.
+	HeaderSignature = "X-Webhook-Signature"
+)
+
+// Defaults applied to a zero Webhook.
+const (
+	DefaultWebhookAttempts = 3
+	DefaultWebhookBackoff  = time.Second
+	DefaultWebhookTimeout  = 10 * time.Second
+)
+
+// Webhook POSTs signed JSON payloads to a URL.
+type Webhook struct {
+	// URL receives the POST requests.
+	URL string
+
+	// Secret signs every request (see HeaderSignature). Empty disables
+	// signing.
+	Secret string
+
+	// HttpClient sends the requests. Defaults to a client with
+	// DefaultWebhookTimeout.
+	HttpClient *http.Client
+
+	// MaxAttempts bounds deliveries of one payload; transport failures
+	// and 5xx responses are retried. Defaults to DefaultWebhookAttempts.
+	MaxAttempts int
+
+	// Backoff is the delay before the first retry, doubled after each
+	// one. Defaults to DefaultWebhookBackoff.
+	Backoff time.Duration
+}
+
+// envelope is the JSON body of every webhook request.
+type envelope struct {
+	Event  string    `json:"event"`
+	SentAt time.Time `json:"sentAt"`
+	Data   any       `json:"data"`
+}
+
+// Send delivers data under the event name, retrying transient failures.
+func (w *Webhook) Send(ctx context.Context, event string, data any) error {
+	body, err := json.Marshal(envelope{Event: event, SentAt: time.Now().UTC(), Data: data})
+	if err != nil {
+		return fmt.Errorf("notify: encode %s: %w", event, err)
+	}
+
+	attempts := w.MaxAttempts
+	if attempts <= 0 {
+		attempts = DefaultWebhookAttempts
+	}
+	backoff := w.Backoff
+	if backoff <= 0 {
+		backoff = DefaultWebhookBackoff
+	}
+
+	for attempt := 1; ; attempt++ {
+		retry, err := w.post(ctx, event, body)
+		if err == nil {
+			return nil
+		}
+		if !retry || attempt >= attempts {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+}
+
+// post performs one delivery and reports whether a failure is retryable.
+func (w *Webhook) post(ctx context.Context, event string, body []byte) (bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.URL, bytes.NewReader(body))
+	if err != nil {
+		return false, fmt.Errorf("notify: build webhook request: %w", err)
+	}
+
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderEvent, event)
+	req.Header.Set(HeaderTimestamp, timestamp)
+	if w.Secret != "" {
+		req.Header.Set(HeaderSignature, Sign(w.Secret, timestamp, body))
+	}
+
+	client := w.HttpClient
+	if client == nil {
+		client = &http.Client{Timeout: DefaultWebhookTimeout}
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return ctx.Err() == nil, fmt.Errorf("notify: deliver %s: %w", event, err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp.StatusCode >= 500 || resp.StatusCode == http.StatusTooManyRequests,
+			fmt.Errorf("notify: deliver %s: status %d", event, resp.StatusCode)
+	}
+	return false, nil
+}
+
+// Notify implements Notifier by sending event under its Kind.
+func (w *Webhook) Notify(ctx context.Context, event Event) error {
+	return w.Send(ctx, event.Kind, event)
+}
+
+// Sign returns the HeaderSignature value for body sent at timestamp.
+func Sign(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify reports whether signature is valid for body and timestamp. It is
+// meant for receivers written in Go.
+//
+// Example:
+//
+//	body, _ := io.ReadAll(r.Body)
+//	ok := notify.Verify(secret, r.Header.Get(notify.HeaderTimestamp), body,
+//	    r.Header.Get(notify.HeaderSignature))
+func Verify(secret, timestamp string, body []byte, signature string) bool {
+	return hmac.Equal([]byte(Sign(secret, timestamp, body)), []byte(signature))
+}
@@ -0,0 +1,129 @@
+package nobitex
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+// RequestOption customizes a single API call without mutating the shared
+// Client. Options are accepted as trailing variadic arguments by Request,
+// ApiRequest and every endpoint method.
+//
+// Example:
+//
+//	order, err := client.CreateOrder(params,
+//	    nobitex.WithContext(ctx),
+//	    nobitex.WithHeader("X-Request-Id", traceID),
+//	)
+type RequestOption func(*requestOptions)
+
+// requestOptions is the resolved per-call configuration.
+type requestOptions struct {
+	ctx     context.Context
+	headers http.Header
+	debug   bool
+	retry   *RetryPolicy
+
+	// idempotent marks a non-GET call as safe to repeat.
+	idempotent bool
+
+	// bypassMaintenance lets recovery probes through while degraded.
+	bypassMaintenance bool
+
+	// timeout bounds one attempt, from sending the request to decoding
+	// the response; zero leaves it to ctx. Set for fully decoded calls.
+	timeout time.Duration
+}
+
+// WithContext binds the call to ctx, so cancellation and deadlines of ctx
+// abort the underlying HTTP request.
+func WithContext(ctx context.Context) RequestOption {
+	return func(o *requestOptions) {
+		o.ctx = ctx
+	}
+}
+
+// WithHeader adds an extra header to a single call, e.g. an idempotency key
+// or trace ID. Headers set this way are applied last and therefore override
+// the SDK defaults for that call.
+func WithHeader(key, value string) RequestOption {
+	return func(o *requestOptions) {
+		if o.headers == nil {
+			o.headers = make(http.Header)
+		}
+		o.headers.Add(key, value)
+	}
+}
+
+// WithHeaders adds every entry of headers to a single call.
+func WithHeaders(headers http.Header) RequestOption {
+	return func(o *requestOptions) {
+		if o.headers == nil {
+			o.headers = make(http.Header)
+		}
+		for key, vals := range headers {
+			for _, val := range vals {
+				o.headers.Add(key, val)
+			}
+		}
+	}
+}
+
+// WithDebug dumps the request and response of a single call to the debug
+// writer, regardless of ClientOptions.Debug. Secrets are masked.
+func WithDebug() RequestOption {
+	return func(o *requestOptions) {
+		o.debug = true
+	}
+}
+
+// WithRetryPolicy overrides ClientOptions.Retry for a single call, e.g. to
+// fail fast on latency-critical order placement while letting historical
+// fetches retry longer.
+//
+// Example:
+//
+//	client.CreateOrder(params, nobitex.WithRetryPolicy(nobitex.RetryPolicy{
+//	    MaxAttempts:    2,
+//	    MaxElapsedTime: 500 * time.Millisecond,
+//	}))
+func WithRetryPolicy(policy RetryPolicy) RequestOption {
+	return func(o *requestOptions) {
+		o.retry = &policy
+	}
+}
+
+// WithoutRetry disables automatic retries for a single call.
+func WithoutRetry() RequestOption {
+	return WithRetryPolicy(RetryPolicy{})
+}
+
+// WithIdempotent declares that repeating a call has no additional side
+// effects, e.g. a POST endpoint that only reads data. Such calls are
+// retried by the client's RetryPolicy.
+func WithIdempotent() RequestOption {
+	return func(o *requestOptions) {
+		o.idempotent = true
+	}
+}
+
+// idempotent prepends WithIdempotent to opts without touching the
+// caller's slice.
+func idempotent(opts []RequestOption) []RequestOption {
+	return append([]RequestOption{WithIdempotent()}, opts...)
+}
+
+// resolveRequestOptions applies opts over the defaults.
+func resolveRequestOptions(opts []RequestOption) *requestOptions {
+	ro := &requestOptions{ctx: context.Background()}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(ro)
+		}
+	}
+	if ro.ctx == nil {
+		ro.ctx = context.Background()
+	}
+	return ro
+}
@@ -0,0 +1,108 @@
+package nobitex
+
+import (
+	"context"
+	"fmt"
+
+	t "github.com/darhelm/go-nobitex/types"
+)
+
+// LimitBuy returns CreateOrderParams for a limit buy of amount base
+// currency at price on symbol ("BTCIRT", "ETHUSDT", ...).
+//
+// Amount and price are validated and written in canonical decimal form.
+// Use Client.ApplyPrecision to round them to the market's steps.
+//
+// Example:
+//
+//	params, err := nobitex.LimitBuy("BTCIRT", "0.01", "6500000000")
+//	if err != nil {
+//	    return err
+//	}
+//	params, err = client.ApplyPrecision(ctx, params)
+//	order, err := client.CreateOrder(params)
+func LimitBuy(symbol string, amount string, price string) (t.CreateOrderParams, error) {
+	return limitOrder("buy", symbol, amount, price)
+}
+
+// LimitSell returns CreateOrderParams for a limit sell of amount base
+// currency at price on symbol. See LimitBuy.
+func LimitSell(symbol string, amount string, price string) (t.CreateOrderParams, error) {
+	return limitOrder("sell", symbol, amount, price)
+}
+
+// limitOrder builds the params shared by LimitBuy and LimitSell.
+func limitOrder(side string, symbol string, amount string, price string) (t.CreateOrderParams, error) {
+	src, dst, err := splitMarketSymbol(symbol)
+	if err != nil {
+		return t.CreateOrderParams{}, err
+	}
+
+	a, err := parseDecimal("amount", amount)
+	if err != nil {
+		return t.CreateOrderParams{}, err
+	}
+	p, err := parseDecimal("price", price)
+	if err != nil {
+		return t.CreateOrderParams{}, err
+	}
+	if a.Sign() <= 0 || p.Sign() <= 0 {
+		return t.CreateOrderParams{}, &GoNobitexError{
+			Message: fmt.Sprintf("amount and price must be positive, got %q @ %q", amount, price),
+		}
+	}
+
+	return t.CreateOrderParams{
+		Execution:   "limit",
+		Type:        side,
+		SrcCurrency: src,
+		DstCurrency: dst,
+		Amount:      FormatDecimal(a),
+		Price:       FormatDecimal(p),
+	}, nil
+}
+
+// ApplyPrecision rounds the amount (down) and prices (to nearest) of
+// params to the steps Nobitex publishes for the market in /options.
+//
+// Returns:
+//   - The rounded copy of params.
+//   - An error if the configuration cannot be fetched, a value cannot be
+//     parsed, or the amount rounds to zero.
+func (c *Client) ApplyPrecision(ctx context.Context, params t.CreateOrderParams) (t.CreateOrderParams, error) {
+	config, err := c.Config(ctx)
+	if err != nil {
+		return params, err
+	}
+
+	market := MarketSymbol(params.SrcCurrency, params.DstCurrency)
+	amountStep := marketAmountStep(&config.Nobitex, market, params.SrcCurrency).Step
+	priceStep := marketPriceStep(&config.Nobitex, market, params.DstCurrency).Step
+
+	if params.Amount != "" {
+		amount, err := parseDecimal("amount", params.Amount)
+		if err != nil {
+			return params, err
+		}
+		amount = roundToStep(amount, amountStep, false)
+		if amount.Sign() <= 0 {
+			return params, &GoNobitexError{
+				Message: fmt.Sprintf("amount %s is below one %s amount step", params.Amount, market),
+			}
+		}
+		params.Amount = FormatDecimal(amount)
+	}
+
+	for _, field := range []*string{&params.Price, &params.StopPrice, &params.StopLimitPrice} {
+		if *field == "" || *field == "market" {
+			continue
+		}
+		price, err := parseDecimal("price", *field)
+		if err != nil {
+			return params, err
+		}
+		*field = FormatDecimal(roundToStep(price, priceStep, true))
+	}
+
+	return params, nil
+}
@@ -0,0 +1,116 @@
+package portfolio
+
+import (
+	"encoding/csv"
+	"io"
+	"math/big"
+	"strconv"
+	"time"
+
+	nobitex "github.com/darhelm/go-nobitex"
+	t "github.com/darhelm/go-nobitex/types"
+)
+
+// Disposal is the part of one acquisition lot consumed by one sell.
+type Disposal struct {
+	// Base and Quote are the currencies of the market; values are in Quote.
+	Base  string
+	Quote string
+
+	// Amount is the base amount disposed of.
+	Amount *big.Rat
+
+	// Acquired and AcquireTradeId identify the buy the lot came from.
+	// Under AverageCost they refer to the first buy of the pooled lot.
+	Acquired       time.Time
+	AcquireTradeId int
+
+	// Disposed and DisposeTradeId identify the sell.
+	Disposed       time.Time
+	DisposeTradeId int
+
+	// CostBasis is the acquisition cost of Amount, fees included.
+	CostBasis *big.Rat
+
+	// Proceeds is the sale value of Amount, net of fees.
+	Proceeds *big.Rat
+
+	// Gain is Proceeds - CostBasis.
+	Gain *big.Rat
+
+	// UnknownAcquired is set when the sold amount had no recorded
+	// acquisition; CostBasis is then zero.
+	UnknownAcquired bool
+}
+
+// TaxLots replays trades with method and returns every disposal, in sell
+// order, lot by lot. Only sells executed within [from, to] are returned;
+// zero bounds are open. Earlier trades are still replayed to establish the
+// cost basis.
+//
+// Example:
+//
+//	lots, err := portfolio.TaxLots(trades, portfolio.FIFO, yearStart, yearEnd)
+//	if err != nil {
+//	    return err
+//	}
+//	return portfolio.WriteDisposalsCSV(file, lots)
+func TaxLots(trades []t.UserTradeResponse, method CostMethod, from, to time.Time) ([]Disposal, error) {
+	var disposals []Disposal
+
+	tracker := NewPositionTracker(method)
+	tracker.OnDisposal(func(d Disposal) {
+		if (from.IsZero() || !d.Disposed.Before(from)) && (to.IsZero() || !d.Disposed.After(to)) {
+			disposals = append(disposals, d)
+		}
+	})
+
+	if err := tracker.AddTrades(trades); err != nil {
+		return nil, err
+	}
+	return disposals, nil
+}
+
+// DisposalColumns is the CSV header written by WriteDisposalsCSV.
+var DisposalColumns = []string{
+	"asset", "quote", "amount", "acquiredAt", "acquireTradeId", "disposedAt",
+	"disposeTradeId", "costBasis", "proceeds", "gain", "holdingDays", "unknownAcquired",
+}
+
+// WriteDisposalsCSV writes one row per disposal in the layout accountants
+// expect for capital gains schedules. Timestamps use RFC 3339.
+func WriteDisposalsCSV(w io.Writer, disposals []Disposal) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(DisposalColumns); err != nil {
+		return err
+	}
+
+	format := nobitex.FormatDecimal
+	formatTime := func(ts time.Time) string {
+		if ts.IsZero() {
+			return ""
+		}
+		return ts.Format(time.RFC3339)
+	}
+
+	for _, d := range disposals {
+		holding := ""
+		if !d.Acquired.IsZero() {
+			holding = strconv.Itoa(int(d.Disposed.Sub(d.Acquired).Hours() / 24))
+		}
+
+		row := []string{
+			d.Base, d.Quote, format(d.Amount),
+			formatTime(d.Acquired), strconv.Itoa(d.AcquireTradeId),
+			formatTime(d.Disposed), strconv.Itoa(d.DisposeTradeId),
+			format(d.CostBasis), format(d.Proceeds), format(d.Gain),
+			holding, strconv.FormatBool(d.UnknownAcquired),
+		}
+		if err := cw.Write(row); err != nil {
+			return err
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
@@ -0,0 +1,422 @@
+package portfolio
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	nobitex "github.com/darhelm/go-nobitex"
+	t "github.com/darhelm/go-nobitex/types"
+)
+
+// PriceSource values currencies in the report currency at a given time.
+// Implementations typically look up candles or stored ticker history.
+type PriceSource interface {
+	// Price returns the value of one unit of currency at the given time.
+	Price(currency string, at time.Time) (*big.Rat, error)
+}
+
+// PriceFunc adapts a plain function to the PriceSource interface.
+type PriceFunc func(currency string, at time.Time) (*big.Rat, error)
+
+// Price calls f(currency, at).
+func (f PriceFunc) Price(currency string, at time.Time) (*big.Rat, error) {
+	return f(currency, at)
+}
+
+// StaticPrices is a PriceSource returning fixed decimal prices regardless
+// of time, e.g. from a single GetTickers snapshot.
+type StaticPrices map[string]string
+
+// Price returns the fixed price of currency.
+func (p StaticPrices) Price(currency string, _ time.Time) (*big.Rat, error) {
+	price, ok := t.Decimal(p[currency]).Rat()
+	if !ok {
+		return nil, fmt.Errorf("no price for %q", currency)
+	}
+	return price, nil
+}
+
+// ReportInput holds the account history a PNL report is built from.
+type ReportInput struct {
+	// From and To bound the reporting period (inclusive).
+	From time.Time
+	To   time.Time
+
+	// Currency is the currency every value is expressed in, e.g. "rls".
+	Currency string
+
+	// Trades is the trade history up to To. Trades before From are needed
+	// to establish the cost basis of positions opened earlier.
+	Trades []t.UserTradeResponse
+
+	// Deposits and Withdrawals are the transfers of the account. Only
+	// those inside the period are reported.
+	Deposits    []t.Deposit
+	Withdrawals []t.Withdrawal
+
+	// Prices values currencies other than Currency.
+	Prices PriceSource
+
+	// Method selects how sells are matched. Defaults to FIFO.
+	Method CostMethod
+}
+
+// AssetPNL is the profit and loss of one base currency over the period.
+// All values are exact decimals in Report.Currency; JSON output renders
+// them as decimal strings.
+type AssetPNL struct {
+	// Asset is the base currency, e.g. "btc".
+	Asset string `json:"asset"`
+
+	// Trades is the number of trades executed in the period.
+	Trades int `json:"trades"`
+
+	// RealizedPNL is the profit of sells executed in the period.
+	RealizedPNL *big.Rat `json:"realizedPnl"`
+
+	// UnrealizedPNL is the change in mark-to-market profit of the open
+	// position over the period: its unrealized profit at To minus that of
+	// the position already held at From.
+	UnrealizedPNL *big.Rat `json:"unrealizedPnl"`
+
+	// Fees is the trading fee paid in the period.
+	Fees *big.Rat `json:"fees"`
+
+	// OpeningAmount and OpeningValue describe the position at From.
+	OpeningAmount *big.Rat `json:"openingAmount"`
+	OpeningValue  *big.Rat `json:"openingValue"`
+
+	// ClosingAmount and ClosingValue describe the position at To.
+	ClosingAmount *big.Rat `json:"closingAmount"`
+	ClosingValue  *big.Rat `json:"closingValue"`
+
+	// Deposited and Withdrawn are the asset amounts transferred in and
+	// out during the period; NetTransferValue is their value at transfer
+	// time (deposits positive).
+	Deposited        *big.Rat `json:"deposited"`
+	Withdrawn        *big.Rat `json:"withdrawn"`
+	NetTransferValue *big.Rat `json:"netTransferValue"`
+
+	// TotalPNL is RealizedPNL + UnrealizedPNL.
+	TotalPNL *big.Rat `json:"totalPnl"`
+}
+
+// newAssetPNL returns an AssetPNL with every figure set to zero.
+func newAssetPNL(asset string) *AssetPNL {
+	a := &AssetPNL{Asset: asset}
+	for _, v := range a.figures() {
+		*v = new(big.Rat)
+	}
+	return a
+}
+
+// figures lists the decimal fields of a, in CSV column order.
+func (a *AssetPNL) figures() []**big.Rat {
+	return []**big.Rat{
+		&a.RealizedPNL, &a.UnrealizedPNL, &a.TotalPNL, &a.Fees,
+		&a.OpeningAmount, &a.OpeningValue, &a.ClosingAmount, &a.ClosingValue,
+		&a.Deposited, &a.Withdrawn, &a.NetTransferValue,
+	}
+}
+
+// MarshalJSON renders the figures as decimal strings rather than the
+// fractions big.Rat produces.
+func (a AssetPNL) MarshalJSON() ([]byte, error) {
+	type plain AssetPNL
+	type decimal = t.Decimal
+	format := func(r *big.Rat) decimal {
+		if r == nil {
+			return "0"
+		}
+		return decimal(nobitex.FormatDecimal(r))
+	}
+	return json.Marshal(struct {
+		plain
+		RealizedPNL      decimal `json:"realizedPnl"`
+		UnrealizedPNL    decimal `json:"unrealizedPnl"`
+		Fees             decimal `json:"fees"`
+		OpeningAmount    decimal `json:"openingAmount"`
+		OpeningValue     decimal `json:"openingValue"`
+		ClosingAmount    decimal `json:"closingAmount"`
+		ClosingValue     decimal `json:"closingValue"`
+		Deposited        decimal `json:"deposited"`
+		Withdrawn        decimal `json:"withdrawn"`
+		NetTransferValue decimal `json:"netTransferValue"`
+		TotalPNL         decimal `json:"totalPnl"`
+	}{
+		plain:            plain(a),
+		RealizedPNL:      format(a.RealizedPNL),
+		UnrealizedPNL:    format(a.UnrealizedPNL),
+		Fees:             format(a.Fees),
+		OpeningAmount:    format(a.OpeningAmount),
+		OpeningValue:     format(a.OpeningValue),
+		ClosingAmount:    format(a.ClosingAmount),
+		ClosingValue:     format(a.ClosingValue),
+		Deposited:        format(a.Deposited),
+		Withdrawn:        format(a.Withdrawn),
+		NetTransferValue: format(a.NetTransferValue),
+		TotalPNL:         format(a.TotalPNL),
+	})
+}
+
+// Report is a PNL report over a date range.
+type Report struct {
+	From     time.Time  `json:"from"`
+	To       time.Time  `json:"to"`
+	Currency string     `json:"currency"`
+	Method   string     `json:"method"`
+	Assets   []AssetPNL `json:"assets"`
+
+	// Total sums every asset.
+	Total AssetPNL `json:"total"`
+}
+
+// BuildReport computes per-asset and total PNL for the period.
+//
+// Behavior:
+//   - Positions are replayed from the first trade, so sells in the period
+//     are matched against buys made before it.
+//   - Quote-currency figures are converted to Currency at the time of the
+//     trade.
+//   - Open positions are marked at From and at To, and UnrealizedPNL is
+//     the change between the two, so profit accrued before the period is
+//     not reported in it.
+//   - Only confirmed deposits and withdrawals not rejected or canceled
+//     are counted.
+//
+// Example:
+//
+//	report, err := portfolio.BuildReport(portfolio.ReportInput{
+//	    From: start, To: end, Currency: "rls",
+//	    Trades: trades, Deposits: deposits, Withdrawals: withdrawals,
+//	    Prices: portfolio.StaticPrices{"btc": "6500000000", "usdt": "600000"},
+//	})
+//	if err != nil {
+//	    return err
+//	}
+//	return report.WriteCSV(os.Stdout)
+func BuildReport(in ReportInput) (*Report, error) {
+	if in.Currency == "" {
+		return nil, fmt.Errorf("report currency is required")
+	}
+
+	value := func(currency string, amount *big.Rat, at time.Time) (*big.Rat, error) {
+		if amount.Sign() == 0 || currency == in.Currency {
+			return new(big.Rat).Set(amount), nil
+		}
+		if in.Prices == nil {
+			return nil, fmt.Errorf("no price source to value %s", currency)
+		}
+		price, err := in.Prices.Price(currency, at)
+		if err != nil {
+			return nil, fmt.Errorf("valuing %s at %s: %w", currency, at.Format(time.RFC3339), err)
+		}
+		return new(big.Rat).Mul(amount, price), nil
+	}
+
+	trades := append([]t.UserTradeResponse(nil), in.Trades...)
+	sort.SliceStable(trades, func(i, j int) bool {
+		return trades[i].Timestamp.Before(trades[j].Timestamp)
+	})
+
+	assets := make(map[string]*AssetPNL)
+	asset := func(name string) *AssetPNL {
+		a, ok := assets[name]
+		if !ok {
+			a = newAssetPNL(name)
+			assets[name] = a
+		}
+		return a
+	}
+
+	// mark values the open positions at the given time and reports, per
+	// asset, the amount held, its value and its unrealized profit
+	tracker := NewPositionTracker(in.Method)
+	mark := func(at time.Time, apply func(a *AssetPNL, amount, value, unrealized *big.Rat)) error {
+		for _, pos := range tracker.Positions() {
+			if pos.Amount.Sign() <= 0 {
+				continue
+			}
+			worth, err := value(pos.Base, pos.Amount, at)
+			if err != nil {
+				return err
+			}
+			basis, err := value(pos.Quote, pos.CostBasis, at)
+			if err != nil {
+				return err
+			}
+			apply(asset(pos.Base), pos.Amount, worth, basis.Sub(worth, basis))
+		}
+		return nil
+	}
+	opened := in.From.IsZero()
+	open := func() error {
+		opened = true
+		return mark(in.From, func(a *AssetPNL, amount, worth, unrealized *big.Rat) {
+			a.OpeningAmount.Add(a.OpeningAmount, amount)
+			a.OpeningValue.Add(a.OpeningValue, worth)
+			a.UnrealizedPNL.Sub(a.UnrealizedPNL, unrealized)
+		})
+	}
+
+	for _, trade := range trades {
+		if !in.To.IsZero() && trade.Timestamp.After(in.To) {
+			break
+		}
+		if !opened && !trade.Timestamp.Before(in.From) {
+			if err := open(); err != nil {
+				return nil, err
+			}
+		}
+
+		before := tracker.Position(trade.SrcCurrency, trade.DstCurrency)
+		if err := tracker.AddTrade(trade); err != nil {
+			return nil, err
+		}
+		if !in.From.IsZero() && trade.Timestamp.Before(in.From) {
+			continue
+		}
+		after := tracker.Position(trade.SrcCurrency, trade.DstCurrency)
+
+		realized, err := value(trade.DstCurrency, after.RealizedPNL.Sub(after.RealizedPNL, before.RealizedPNL), trade.Timestamp)
+		if err != nil {
+			return nil, err
+		}
+		fees, err := value(trade.DstCurrency, after.Fees.Sub(after.Fees, before.Fees), trade.Timestamp)
+		if err != nil {
+			return nil, err
+		}
+
+		a := asset(trade.SrcCurrency)
+		a.Trades++
+		a.RealizedPNL.Add(a.RealizedPNL, realized)
+		a.Fees.Add(a.Fees, fees)
+	}
+
+	if !opened {
+		if err := open(); err != nil {
+			return nil, err
+		}
+	}
+
+	valuedAt := in.To
+	if valuedAt.IsZero() {
+		valuedAt = time.Now()
+	}
+	err := mark(valuedAt, func(a *AssetPNL, amount, worth, unrealized *big.Rat) {
+		a.ClosingAmount.Add(a.ClosingAmount, amount)
+		a.ClosingValue.Add(a.ClosingValue, worth)
+		a.UnrealizedPNL.Add(a.UnrealizedPNL, unrealized)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	inPeriod := func(ts time.Time) bool {
+		return (in.From.IsZero() || !ts.Before(in.From)) && (in.To.IsZero() || !ts.After(in.To))
+	}
+
+	for _, deposit := range in.Deposits {
+		if !deposit.IsConfirmed || !inPeriod(deposit.Date) {
+			continue
+		}
+		amount, ok := t.Decimal(deposit.Amount).Rat()
+		if !ok {
+			return nil, fmt.Errorf("deposit %d: invalid amount %q", deposit.Id, deposit.Amount)
+		}
+		v, err := value(deposit.Currency, amount, deposit.Date)
+		if err != nil {
+			return nil, err
+		}
+		a := asset(deposit.Currency)
+		a.Deposited.Add(a.Deposited, amount)
+		a.NetTransferValue.Add(a.NetTransferValue, v)
+	}
+
+	for _, withdrawal := range in.Withdrawals {
+		status := strings.ToLower(withdrawal.Status)
+		if status == "rejected" || status == "canceled" || !inPeriod(withdrawal.CreatedAt) {
+			continue
+		}
+		amount, ok := t.Decimal(withdrawal.Amount).Rat()
+		if !ok {
+			return nil, fmt.Errorf("withdrawal %d: invalid amount %q", withdrawal.Id, withdrawal.Amount)
+		}
+		v, err := value(withdrawal.Currency, amount, withdrawal.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		a := asset(withdrawal.Currency)
+		a.Withdrawn.Add(a.Withdrawn, amount)
+		a.NetTransferValue.Sub(a.NetTransferValue, v)
+	}
+
+	report := &Report{
+		From:     in.From,
+		To:       in.To,
+		Currency: in.Currency,
+		Method:   in.Method.String(),
+		Total:    *newAssetPNL("total"),
+	}
+	for _, a := range assets {
+		a.TotalPNL.Add(a.RealizedPNL, a.UnrealizedPNL)
+		report.Assets = append(report.Assets, *a)
+
+		total := &report.Total
+		total.Trades += a.Trades
+		total.RealizedPNL.Add(total.RealizedPNL, a.RealizedPNL)
+		total.UnrealizedPNL.Add(total.UnrealizedPNL, a.UnrealizedPNL)
+		total.Fees.Add(total.Fees, a.Fees)
+		total.OpeningValue.Add(total.OpeningValue, a.OpeningValue)
+		total.ClosingValue.Add(total.ClosingValue, a.ClosingValue)
+		total.NetTransferValue.Add(total.NetTransferValue, a.NetTransferValue)
+		total.TotalPNL.Add(total.TotalPNL, a.TotalPNL)
+	}
+	sort.Slice(report.Assets, func(i, j int) bool {
+		return report.Assets[i].Asset < report.Assets[j].Asset
+	})
+
+	return report, nil
+}
+
+// reportColumns is the CSV header written by Report.WriteCSV.
+var reportColumns = []string{
+	"asset", "trades", "realizedPnl", "unrealizedPnl", "totalPnl", "fees",
+	"openingAmount", "openingValue", "closingAmount", "closingValue",
+	"deposited", "withdrawn", "netTransferValue",
+}
+
+// WriteCSV writes one row per asset followed by a "total" row.
+func (r *Report) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(reportColumns); err != nil {
+		return err
+	}
+
+	for _, a := range append(r.Assets, r.Total) {
+		row := []string{a.Asset, strconv.Itoa(a.Trades)}
+		for _, v := range a.figures() {
+			row = append(row, nobitex.FormatDecimal(*v))
+		}
+		if err := cw.Write(row); err != nil {
+			return err
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
+
+// WriteJSON writes the report as indented JSON.
+func (r *Report) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(r)
+}
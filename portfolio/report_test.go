@@ -0,0 +1,63 @@
+package portfolio
+
+import (
+	"bytes"
+	"encoding/json"
+	"math/big"
+	"testing"
+	"time"
+
+	nobitex "github.com/darhelm/go-nobitex"
+	"github.com/darhelm/go-nobitex/types"
+)
+
+func TestBuildReportMarksAtFrom(t *testing.T) {
+	from := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	// Bought at 100 before the period, worth 150 at From and 180 at To
+	prices := PriceFunc(func(currency string, at time.Time) (*big.Rat, error) {
+		if at.Before(to) {
+			return big.NewRat(150, 1), nil
+		}
+		return big.NewRat(180, 1), nil
+	})
+
+	report, err := BuildReport(ReportInput{
+		From: from, To: to, Currency: "usdt",
+		Trades: []types.UserTradeResponse{trade(1, "buy", "1", "100", "")},
+		Prices: prices,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(report.Assets) != 1 {
+		t.Fatalf("assets = %+v, want btc only", report.Assets)
+	}
+
+	btc := report.Assets[0]
+	got := map[string]*big.Rat{
+		"unrealized": btc.UnrealizedPNL, "opening": btc.OpeningValue,
+		"closing": btc.ClosingValue, "total": btc.TotalPNL,
+	}
+	want := map[string]string{"unrealized": "30", "opening": "150", "closing": "180", "total": "30"}
+	for name, value := range got {
+		if nobitex.FormatDecimal(value) != want[name] {
+			t.Errorf("%s = %s, want %s", name, nobitex.FormatDecimal(value), want[name])
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := report.WriteJSON(&buf); err != nil {
+		t.Fatal(err)
+	}
+	var decoded struct {
+		Assets []map[string]any `json:"assets"`
+	}
+	if err := json.Unmarshal(buf.Bytes(), &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if v := decoded.Assets[0]["unrealizedPnl"]; v != "30" {
+		t.Errorf("JSON unrealizedPnl = %v, want \"30\"", v)
+	}
+}
@@ -0,0 +1,403 @@
+// Package portfolio reconstructs spot positions, profit and loss and tax
+// lots from the user's Nobitex trade history, which the exchange does not
+// provide for spot accounts.
+package portfolio
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+	"time"
+
+	t "github.com/darhelm/go-nobitex/types"
+)
+
+// CostMethod selects how sells are matched against earlier buys.
+type CostMethod int
+
+const (
+	// FIFO matches sells against the oldest open lots first.
+	FIFO CostMethod = iota
+
+	// AverageCost values every sold unit at the running average entry
+	// price of the position.
+	AverageCost
+
+	// LIFO matches sells against the most recent open lots first.
+	LIFO
+)
+
+// String returns the method name.
+func (m CostMethod) String() string {
+	switch m {
+	case FIFO:
+		return "fifo"
+	case AverageCost:
+		return "average"
+	case LIFO:
+		return "lifo"
+	default:
+		return fmt.Sprintf("CostMethod(%d)", int(m))
+	}
+}
+
+// lot is an open acquisition: amount units bought at unitCost (fees
+// included) in the quote currency.
+type lot struct {
+	tradeId  int
+	acquired time.Time
+	amount   *big.Rat
+	unitCost *big.Rat
+}
+
+// match is the part of a lot consumed by a sell.
+type match struct {
+	lot    lot
+	amount *big.Rat
+}
+
+// Position is the state of one market (base/quote pair). Amounts and
+// values are exact decimals; format them with nobitex.FormatDecimal.
+type Position struct {
+	// Base and Quote are the currencies of the market, e.g. "btc"/"rls".
+	// Cost and PNL figures are in Quote.
+	Base  string
+	Quote string
+
+	// Amount is the net base amount held, after buy fees.
+	Amount *big.Rat
+
+	// AverageEntry is the average cost per held unit, fees included.
+	AverageEntry *big.Rat
+
+	// CostBasis is AverageEntry × Amount.
+	CostBasis *big.Rat
+
+	// RealizedPNL is the profit of all sells so far, net of fees.
+	RealizedPNL *big.Rat
+
+	// Fees is the total fee paid, converted to Quote.
+	Fees *big.Rat
+
+	// Unmatched is the base amount sold without a recorded acquisition in
+	// this market, e.g. coins deposited from outside, bought in another
+	// quote currency or before the history start. It is realized with a
+	// zero cost basis.
+	Unmatched *big.Rat
+
+	// Trades is the number of trades applied.
+	Trades int
+
+	// LastTradeAt is the time of the newest applied trade.
+	LastTradeAt time.Time
+}
+
+// PositionTracker maintains per-market positions, average entry prices
+// and realized PNL from a stream of user trades.
+//
+// Positions are kept per market, not per asset: btc bought in BTCIRT and
+// btc bought in BTCUSDT are two positions, each valued in its own quote
+// currency, and a sell only consumes lots bought in the same market. Use
+// BuildReport for per-asset figures converted to one currency.
+//
+// Trades should be added in execution order; AddTrades sorts a batch
+// before applying it. Trades already applied (by Id) are skipped, so
+// overlapping pages are harmless. PositionTracker is not safe for
+// concurrent use.
+//
+// Example:
+//
+//	tracker := portfolio.NewPositionTracker(portfolio.FIFO)
+//	for trade, err := range client.UserTrades(ctx, t.GetUserTradesParams{}) {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    if err := tracker.AddTrade(trade); err != nil {
+//	        return err
+//	    }
+//	}
+//	pos := tracker.Position("btc", "rls")
+//	fmt.Println(nobitex.FormatDecimal(pos.Amount), nobitex.FormatDecimal(pos.RealizedPNL))
+type PositionTracker struct {
+	method    CostMethod
+	positions map[string]*positionState
+	seen      map[int]struct{}
+
+	onDisposal func(d Disposal)
+}
+
+// positionState is a Position together with its open lots.
+type positionState struct {
+	Position
+	lots []lot
+}
+
+// NewPositionTracker returns an empty tracker using method.
+func NewPositionTracker(method CostMethod) *PositionTracker {
+	return &PositionTracker{
+		method:    method,
+		positions: make(map[string]*positionState),
+		seen:      make(map[int]struct{}),
+	}
+}
+
+// OnDisposal registers fn to receive every lot piece consumed by a sell,
+// which is the input of capital gains reporting (see TaxLots).
+func (pt *PositionTracker) OnDisposal(fn func(d Disposal)) {
+	pt.onDisposal = fn
+}
+
+// Method returns the cost method of the tracker.
+func (pt *PositionTracker) Method() CostMethod {
+	return pt.method
+}
+
+// AddTrades applies a batch of trades in timestamp order.
+func (pt *PositionTracker) AddTrades(trades []t.UserTradeResponse) error {
+	sorted := append([]t.UserTradeResponse(nil), trades...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].Timestamp.Equal(sorted[j].Timestamp) {
+			return sorted[i].Id < sorted[j].Id
+		}
+		return sorted[i].Timestamp.Before(sorted[j].Timestamp)
+	})
+
+	for _, trade := range sorted {
+		if err := pt.AddTrade(trade); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AddTrade applies a single trade.
+//
+// Behavior:
+//   - Buys pay their fee in the base currency: the position grows by
+//     Amount - Fee and the full quote total becomes cost basis.
+//   - Sells pay their fee in the quote currency: proceeds are
+//     Amount × Price - Fee.
+func (pt *PositionTracker) AddTrade(trade t.UserTradeResponse) error {
+	if trade.Id != 0 {
+		if _, ok := pt.seen[trade.Id]; ok {
+			return nil
+		}
+	}
+
+	amount, err := parseField(trade, "amount", trade.Amount)
+	if err != nil {
+		return err
+	}
+	price, err := parseField(trade, "price", trade.Price)
+	if err != nil {
+		return err
+	}
+	fee := new(big.Rat)
+	if trade.Fee != "" {
+		if fee, err = parseField(trade, "fee", trade.Fee); err != nil {
+			return err
+		}
+	}
+	if amount.Sign() <= 0 {
+		return fmt.Errorf("trade %d: amount must be positive, got %s", trade.Id, trade.Amount)
+	}
+
+	state := pt.state(trade.SrcCurrency, trade.DstCurrency)
+	total := new(big.Rat).Mul(amount, price)
+
+	switch trade.Type {
+	case "buy":
+		received := new(big.Rat).Sub(amount, fee)
+		if received.Sign() <= 0 {
+			return fmt.Errorf("trade %d: fee %s exceeds amount %s", trade.Id, trade.Fee, trade.Amount)
+		}
+		pt.buy(state, lot{
+			tradeId:  trade.Id,
+			acquired: trade.Timestamp,
+			amount:   received,
+			unitCost: new(big.Rat).Quo(total, received),
+		})
+		state.Fees.Add(state.Fees, new(big.Rat).Mul(fee, price))
+	case "sell":
+		proceeds := new(big.Rat).Sub(total, fee)
+		pt.sell(state, trade, amount, proceeds)
+		state.Fees.Add(state.Fees, fee)
+	default:
+		return fmt.Errorf("trade %d: unknown type %q", trade.Id, trade.Type)
+	}
+
+	state.Trades++
+	if trade.Timestamp.After(state.LastTradeAt) {
+		state.LastTradeAt = trade.Timestamp
+	}
+	if trade.Id != 0 {
+		pt.seen[trade.Id] = struct{}{}
+	}
+	pt.refresh(state)
+	return nil
+}
+
+// buy adds an acquisition lot.
+func (pt *PositionTracker) buy(state *positionState, l lot) {
+	if pt.method == AverageCost && len(state.lots) > 0 {
+		held := &state.lots[0]
+		total := new(big.Rat).Add(held.amount, l.amount)
+		cost := new(big.Rat).Mul(held.amount, held.unitCost)
+		cost.Add(cost, new(big.Rat).Mul(l.amount, l.unitCost))
+		held.unitCost = cost.Quo(cost, total)
+		held.amount = total
+		return
+	}
+	state.lots = append(state.lots, l)
+}
+
+// sell consumes lots for amount units and realizes the result.
+func (pt *PositionTracker) sell(state *positionState, trade t.UserTradeResponse, amount, proceeds *big.Rat) {
+	unitProceeds := new(big.Rat).Quo(proceeds, amount)
+	remaining := new(big.Rat).Set(amount)
+
+	var matches []match
+	for remaining.Sign() > 0 && len(state.lots) > 0 {
+		idx := 0
+		if pt.method == LIFO {
+			idx = len(state.lots) - 1
+		}
+
+		next := &state.lots[idx]
+		take := new(big.Rat).Set(remaining)
+		if next.amount.Cmp(take) < 0 {
+			take.Set(next.amount)
+		}
+		matches = append(matches, match{lot: *next, amount: take})
+
+		next.amount = new(big.Rat).Sub(next.amount, take)
+		remaining.Sub(remaining, take)
+		if next.amount.Sign() <= 0 {
+			state.lots = append(state.lots[:idx], state.lots[idx+1:]...)
+		}
+	}
+
+	for _, m := range matches {
+		gain := new(big.Rat).Sub(unitProceeds, m.lot.unitCost)
+		state.RealizedPNL.Add(state.RealizedPNL, gain.Mul(gain, m.amount))
+		pt.dispose(state, trade, m.lot, m.amount, unitProceeds)
+	}
+
+	if remaining.Sign() > 0 {
+		state.Unmatched.Add(state.Unmatched, remaining)
+		state.RealizedPNL.Add(state.RealizedPNL, new(big.Rat).Mul(remaining, unitProceeds))
+		pt.dispose(state, trade, lot{unitCost: new(big.Rat)}, remaining, unitProceeds)
+	}
+}
+
+// dispose reports a consumed lot piece to onDisposal.
+func (pt *PositionTracker) dispose(state *positionState, trade t.UserTradeResponse, l lot, amount, unitProceeds *big.Rat) {
+	if pt.onDisposal == nil {
+		return
+	}
+
+	costBasis := new(big.Rat).Mul(amount, l.unitCost)
+	proceeds := new(big.Rat).Mul(amount, unitProceeds)
+	pt.onDisposal(Disposal{
+		Base:            state.Base,
+		Quote:           state.Quote,
+		Amount:          new(big.Rat).Set(amount),
+		Acquired:        l.acquired,
+		AcquireTradeId:  l.tradeId,
+		Disposed:        trade.Timestamp,
+		DisposeTradeId:  trade.Id,
+		CostBasis:       costBasis,
+		Proceeds:        proceeds,
+		Gain:            new(big.Rat).Sub(proceeds, costBasis),
+		UnknownAcquired: l.tradeId == 0 && l.acquired.IsZero(),
+	})
+}
+
+// refresh recomputes the derived Position fields from the open lots.
+func (pt *PositionTracker) refresh(state *positionState) {
+	state.Amount, state.CostBasis = new(big.Rat), new(big.Rat)
+	for _, l := range state.lots {
+		state.Amount.Add(state.Amount, l.amount)
+		state.CostBasis.Add(state.CostBasis, new(big.Rat).Mul(l.amount, l.unitCost))
+	}
+
+	state.AverageEntry = new(big.Rat)
+	if state.Amount.Sign() > 0 {
+		state.AverageEntry.Quo(state.CostBasis, state.Amount)
+	}
+}
+
+// state returns the position of a market, creating it if needed.
+func (pt *PositionTracker) state(base, quote string) *positionState {
+	key := base + "-" + quote
+	state, ok := pt.positions[key]
+	if !ok {
+		state = &positionState{Position: emptyPosition(base, quote)}
+		pt.positions[key] = state
+	}
+	return state
+}
+
+// Position returns a snapshot of the position in the base/quote market.
+// Unknown markets yield an empty position.
+func (pt *PositionTracker) Position(base, quote string) Position {
+	if state, ok := pt.positions[base+"-"+quote]; ok {
+		return state.Position.clone()
+	}
+	return emptyPosition(base, quote)
+}
+
+// Positions returns snapshots of every tracked market, sorted by market.
+func (pt *PositionTracker) Positions() []Position {
+	positions := make([]Position, 0, len(pt.positions))
+	for _, state := range pt.positions {
+		positions = append(positions, state.Position.clone())
+	}
+	sort.Slice(positions, func(i, j int) bool {
+		if positions[i].Base != positions[j].Base {
+			return positions[i].Base < positions[j].Base
+		}
+		return positions[i].Quote < positions[j].Quote
+	})
+	return positions
+}
+
+// UnrealizedPNL returns the profit of the open position in base/quote if
+// it were valued at price.
+func (pt *PositionTracker) UnrealizedPNL(base, quote string, price *big.Rat) *big.Rat {
+	pos := pt.Position(base, quote)
+	value := new(big.Rat).Mul(pos.Amount, price)
+	return value.Sub(value, pos.CostBasis)
+}
+
+// emptyPosition returns a flat position with every figure set to zero.
+func emptyPosition(base, quote string) Position {
+	return Position{
+		Base:         base,
+		Quote:        quote,
+		Amount:       new(big.Rat),
+		AverageEntry: new(big.Rat),
+		CostBasis:    new(big.Rat),
+		RealizedPNL:  new(big.Rat),
+		Fees:         new(big.Rat),
+		Unmatched:    new(big.Rat),
+	}
+}
+
+// clone returns a copy of p that shares no values with the tracker.
+func (p Position) clone() Position {
+	c := p
+	for _, v := range []**big.Rat{&c.Amount, &c.AverageEntry, &c.CostBasis, &c.RealizedPNL, &c.Fees, &c.Unmatched} {
+		*v = new(big.Rat).Set(*v)
+	}
+	return c
+}
+
+// parseField parses a decimal trade field.
+func parseField(trade t.UserTradeResponse, name string, value string) (*big.Rat, error) {
+	v, ok := t.Decimal(value).Rat()
+	if !ok {
+		return nil, fmt.Errorf("trade %d: invalid %s %q", trade.Id, name, value)
+	}
+	return v, nil
+}
@@ -0,0 +1,91 @@
+package portfolio
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	nobitex "github.com/darhelm/go-nobitex"
+	"github.com/darhelm/go-nobitex/types"
+)
+
+func trade(id int, side, amount, price, fee string) types.UserTradeResponse {
+	return types.UserTradeResponse{
+		Id:          id,
+		SrcCurrency: "btc",
+		DstCurrency: "usdt",
+		Type:        side,
+		Amount:      amount,
+		Price:       price,
+		Fee:         fee,
+		Timestamp:   time.Date(2026, 1, 1, 0, id, 0, 0, time.UTC),
+	}
+}
+
+func TestPositionTrackerMethods(t *testing.T) {
+	trades := []types.UserTradeResponse{
+		trade(1, "buy", "0.1", "100", ""),
+		trade(2, "buy", "0.2", "200", ""),
+		trade(3, "sell", "0.15", "300", "0.5"),
+	}
+
+	tests := []struct {
+		method                  CostMethod
+		amount, basis, realized string
+	}{
+		// FIFO sells 0.1@100 and 0.05@200: proceeds 44.5, basis 20
+		{FIFO, "0.15", "30", "24.5"},
+		// LIFO sells 0.15@200: basis 30
+		{LIFO, "0.15", "20", "14.5"},
+		// Average entry is 50/0.3: basis of 0.15 is 25
+		{AverageCost, "0.15", "25", "19.5"},
+	}
+	for _, tt := range tests {
+		tracker := NewPositionTracker(tt.method)
+		if err := tracker.AddTrades(trades); err != nil {
+			t.Fatal(err)
+		}
+		pos := tracker.Position("btc", "usdt")
+
+		got := []string{nobitex.FormatDecimal(pos.Amount), nobitex.FormatDecimal(pos.CostBasis), nobitex.FormatDecimal(pos.RealizedPNL)}
+		want := []string{tt.amount, tt.basis, tt.realized}
+		for i := range got {
+			if got[i] != want[i] {
+				t.Errorf("%s: amount/basis/realized = %v, want %v", tt.method, got, want)
+				break
+			}
+		}
+	}
+}
+
+func TestPositionTrackerIsExact(t *testing.T) {
+	tracker := NewPositionTracker(FIFO)
+	for i := 1; i <= 3; i++ {
+		if err := tracker.AddTrade(trade(i, "buy", "0.1", "1", "")); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := tracker.AddTrade(trade(4, "sell", "0.3", "1", "")); err != nil {
+		t.Fatal(err)
+	}
+
+	pos := tracker.Position("btc", "usdt")
+	if pos.Amount.Sign() != 0 || pos.Unmatched.Sign() != 0 {
+		t.Fatalf("amount %s, unmatched %s after selling everything; want 0 and 0",
+			pos.Amount.FloatString(20), pos.Unmatched.FloatString(20))
+	}
+}
+
+func TestPositionSnapshotIsDetached(t *testing.T) {
+	tracker := NewPositionTracker(FIFO)
+	if err := tracker.AddTrade(trade(1, "buy", "1", "10", "")); err != nil {
+		t.Fatal(err)
+	}
+
+	pos := tracker.Position("btc", "usdt")
+	pos.Amount.Add(pos.Amount, big.NewRat(5, 1))
+
+	if got := tracker.Position("btc", "usdt").Amount; got.Cmp(big.NewRat(1, 1)) != 0 {
+		t.Fatalf("tracker amount changed through a snapshot: %s", got.FloatString(2))
+	}
+}
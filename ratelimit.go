@@ -0,0 +1,292 @@
+package nobitex
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// EndpointFamily groups endpoints that share a Nobitex rate limit.
+type EndpointFamily string
+
+const (
+	// FamilyOrders covers order placement, cancellation and status.
+	FamilyOrders EndpointFamily = "orders"
+
+	// FamilyMarketData covers tickers, order books, trades, UDF candles and
+	// /options.
+	FamilyMarketData EndpointFamily = "market"
+
+	// FamilyAuth covers login.
+	FamilyAuth EndpointFamily = "auth"
+
+	// FamilyAccount covers wallets, deposits, withdrawals and user trades.
+	FamilyAccount EndpointFamily = "account"
+
+	// FamilyOther covers every remaining endpoint.
+	FamilyOther EndpointFamily = "other"
+)
+
+// RateLimit is a token bucket: Requests calls per Per, with bursts of up
+// to Burst calls (defaults to Requests).
+type RateLimit struct {
+	Requests int
+	Per      time.Duration
+	Burst    int
+}
+
+// tokenBucket is a minimal blocking token bucket.
+type tokenBucket struct {
+	mu       sync.Mutex
+	rate     float64 // tokens per second
+	capacity float64
+	tokens   float64
+	last     time.Time
+}
+
+// newTokenBucket builds a full bucket for limit.
+func newTokenBucket(limit RateLimit) *tokenBucket {
+	burst := limit.Burst
+	if burst <= 0 {
+		burst = limit.Requests
+	}
+	return &tokenBucket{
+		rate:     float64(limit.Requests) / limit.Per.Seconds(),
+		capacity: float64(burst),
+		tokens:   float64(burst),
+		last:     time.Now(),
+	}
+}
+
+// wait takes one token, sleeping until one is available or ctx is done.
+func (b *tokenBucket) wait(ctx context.Context) error {
+	for {
+		b.mu.Lock()
+		now := time.Now()
+		b.tokens = min(b.capacity, b.tokens+now.Sub(b.last).Seconds()*b.rate)
+		b.last = now
+
+		if b.tokens >= 1 {
+			b.tokens--
+			b.mu.Unlock()
+			return nil
+		}
+		delay := time.Duration((1 - b.tokens) / b.rate * float64(time.Second))
+		b.mu.Unlock()
+
+		if err := sleepContext(ctx, delay); err != nil {
+			return err
+		}
+	}
+}
+
+// available returns the tokens currently in the bucket and how long until
+// it is full again.
+func (b *tokenBucket) available() (float64, time.Duration) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	tokens := min(b.capacity, b.tokens+time.Since(b.last).Seconds()*b.rate)
+	refill := time.Duration((b.capacity - tokens) / b.rate * float64(time.Second))
+	return tokens, refill
+}
+
+// rateLimiters holds one bucket per configured endpoint family and the
+// limit state last reported by the server.
+type rateLimiters struct {
+	buckets map[EndpointFamily]*tokenBucket
+	limits  map[EndpointFamily]RateLimit
+	server  *serverLimits
+}
+
+// newRateLimiters builds buckets for every valid limit.
+func newRateLimiters(limits map[EndpointFamily]RateLimit) rateLimiters {
+	buckets := make(map[EndpointFamily]*tokenBucket, len(limits))
+	configured := make(map[EndpointFamily]RateLimit, len(limits))
+	for family, limit := range limits {
+		if limit.Requests > 0 && limit.Per > 0 {
+			buckets[family] = newTokenBucket(limit)
+			configured[family] = limit
+		}
+	}
+	return rateLimiters{buckets: buckets, limits: configured, server: &serverLimits{}}
+}
+
+// ServerLimit is the rate limit state last reported by Nobitex for an
+// endpoint family, through X-RateLimit-* headers or a 429 response.
+type ServerLimit struct {
+	// Limit and Remaining are the X-RateLimit-Limit and
+	// X-RateLimit-Remaining values; -1 when the server did not send them.
+	Limit     int
+	Remaining int
+
+	// Reset is when the server window resets, from X-RateLimit-Reset or
+	// Retry-After; zero when unknown.
+	Reset time.Time
+
+	// Throttled reports whether the last response was a 429.
+	Throttled bool
+
+	// ObservedAt is when this state was recorded.
+	ObservedAt time.Time
+}
+
+// RateLimitState describes the request budget of an endpoint family.
+type RateLimitState struct {
+	// Family is the endpoint family described.
+	Family EndpointFamily
+
+	// Local reports whether a client-side limit is configured through
+	// ClientOptions.RateLimits; Limit, Remaining and RefillIn are only set
+	// when it is.
+	Local bool
+
+	// Limit is the configured client-side limit.
+	Limit RateLimit
+
+	// Remaining is the number of calls that can be made right now without
+	// waiting, possibly fractional.
+	Remaining float64
+
+	// RefillIn is how long until the local bucket is full again.
+	RefillIn time.Duration
+
+	// Server is the last state reported by Nobitex, if any.
+	Server *ServerLimit
+}
+
+// serverLimits records ServerLimit per family.
+type serverLimits struct {
+	mu     sync.Mutex
+	states map[EndpointFamily]ServerLimit
+}
+
+// observe records the rate limit headers of resp, if it carries any.
+func (s *serverLimits) observe(family EndpointFamily, resp *http.Response) {
+	state := ServerLimit{
+		Limit:      headerInt(resp.Header, "X-RateLimit-Limit"),
+		Remaining:  headerInt(resp.Header, "X-RateLimit-Remaining"),
+		Throttled:  resp.StatusCode == http.StatusTooManyRequests,
+		ObservedAt: time.Now(),
+	}
+	if reset := headerInt(resp.Header, "X-RateLimit-Reset"); reset > 0 {
+		// Either a unix timestamp or seconds from now
+		if reset > 1_000_000_000 {
+			state.Reset = time.Unix(int64(reset), 0)
+		} else {
+			state.Reset = state.ObservedAt.Add(time.Duration(reset) * time.Second)
+		}
+	}
+	if retryAfter := headerInt(resp.Header, "Retry-After"); retryAfter >= 0 && state.Throttled {
+		state.Reset = state.ObservedAt.Add(time.Duration(retryAfter) * time.Second)
+	}
+	if state.Limit < 0 && state.Remaining < 0 && !state.Throttled {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.states == nil {
+		s.states = make(map[EndpointFamily]ServerLimit)
+	}
+	s.states[family] = state
+}
+
+// get returns the last observed state of family.
+func (s *serverLimits) get(family EndpointFamily) (ServerLimit, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	state, ok := s.states[family]
+	return state, ok
+}
+
+// headerInt parses an integer header; -1 when absent or malformed.
+func headerInt(header http.Header, key string) int {
+	n, err := strconv.Atoi(strings.TrimSpace(header.Get(key)))
+	if err != nil {
+		return -1
+	}
+	return n
+}
+
+// observe records the server-side limit state of a response.
+func (r rateLimiters) observe(rawURL string, resp *http.Response) {
+	if r.server != nil {
+		r.server.observe(endpointFamily(rawURL), resp)
+	}
+}
+
+// RateLimit reports the request budget of an endpoint family: the tokens
+// left in the local bucket configured through ClientOptions.RateLimits and
+// the limit state Nobitex last reported, so schedulers can plan requests
+// instead of discovering limits through 429 responses.
+//
+// Example:
+//
+//	state := client.RateLimit(nobitex.FamilyOrders)
+//	if state.Local && state.Remaining < 5 {
+//	    time.Sleep(state.RefillIn)
+//	}
+//	if state.Server != nil && state.Server.Throttled {
+//	    time.Sleep(time.Until(state.Server.Reset))
+//	}
+func (c *Client) RateLimit(family EndpointFamily) RateLimitState {
+	state := RateLimitState{Family: family}
+
+	if bucket, ok := c.rateLimits.buckets[family]; ok {
+		state.Local = true
+		state.Limit = c.rateLimits.limits[family]
+		state.Remaining, state.RefillIn = bucket.available()
+	}
+	if c.rateLimits.server != nil {
+		if server, ok := c.rateLimits.server.get(family); ok {
+			state.Server = &server
+		}
+	}
+	return state
+}
+
+// wait blocks until the family of rawURL has budget for one more call.
+func (r rateLimiters) wait(ctx context.Context, rawURL string) error {
+	if len(r.buckets) == 0 {
+		return nil
+	}
+
+	bucket, ok := r.buckets[endpointFamily(rawURL)]
+	if !ok {
+		return nil
+	}
+	return bucket.wait(ctx)
+}
+
+// endpointFamily classifies a request URL.
+func endpointFamily(rawURL string) EndpointFamily {
+	path := rawURL
+	if u, err := url.Parse(rawURL); err == nil {
+		path = u.Path
+	}
+
+	switch {
+	case strings.Contains(path, "/market/orders"):
+		return FamilyOrders
+	case strings.Contains(path, "/auth/"):
+		return FamilyAuth
+	// The user's trades (/market/trades/list) before public ones (/v2/trades/)
+	case strings.Contains(path, "/market/trades"):
+		return FamilyAccount
+	case strings.Contains(path, "/market/stats"), strings.Contains(path, "/market/udf/"),
+		strings.Contains(path, "/orderbook"), strings.Contains(path, "/trades/"),
+		strings.HasSuffix(path, "/options"):
+		return FamilyMarketData
+	case strings.Contains(path, "/users/"), strings.Contains(path, "/wallets"),
+		strings.Contains(path, "/withdraws/"):
+		return FamilyAccount
+	default:
+		return FamilyOther
+	}
+}
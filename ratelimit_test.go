@@ -0,0 +1,61 @@
+package nobitex
+
+import "testing"
+
+func TestEndpointFamily(t *testing.T) {
+	tests := []struct {
+		path string
+		want EndpointFamily
+	}{
+		// Orders
+		{"/market/orders/add", FamilyOrders},
+		{"/market/orders/cancel-old", FamilyOrders},
+		{"/market/orders/list?status=open", FamilyOrders},
+		{"/market/orders/status", FamilyOrders},
+		{"/market/orders/update-status", FamilyOrders},
+
+		// Auth
+		{"/auth/login/", FamilyAuth},
+
+		// Market data
+		{"/market/stats?srcCurrency=btc", FamilyMarketData},
+		{"/v3/orderbook/BTCIRT", FamilyMarketData},
+		{"/v3/orderbook/all", FamilyMarketData},
+		{"/v2/trades/BTCIRT", FamilyMarketData},
+		{"/v2/options", FamilyMarketData},
+		{"/market/udf/history?symbol=BTCIRT&resolution=60", FamilyMarketData},
+		{"/market/udf/symbols?symbol=BTCIRT", FamilyMarketData},
+
+		// Account
+		{"/market/trades/list?fromId=10", FamilyAccount},
+		{"/v2/wallets", FamilyAccount},
+		{"/v3/wallets", FamilyAccount},
+		{"/users/wallets/list", FamilyAccount},
+		{"/users/wallets/deposits/list", FamilyAccount},
+		{"/users/wallets/withdraws/list", FamilyAccount},
+		{"/users/wallets/deposit/shetab", FamilyAccount},
+		{"/users/wallets/deposit/shetab/status", FamilyAccount},
+		{"/users/upload-file", FamilyAccount},
+		{"/withdraws/42", FamilyAccount},
+
+		// Other
+		{"/check/health", FamilyOther},
+		{"/exchange/get-quote", FamilyOther},
+		{"/exchange/create-trade", FamilyOther},
+		{"/loan/plan/list", FamilyOther},
+		{"/loan/list", FamilyOther},
+		{"/loan/create", FamilyOther},
+		{"/loan/repay", FamilyOther},
+		{"/earn/plan/list", FamilyOther},
+		{"/earn/reward/list", FamilyOther},
+		{"/earn/subscription/subscribe", FamilyOther},
+		{"/earn/subscription/redeem", FamilyOther},
+	}
+
+	for _, tt := range tests {
+		url := "https://apiv2.nobitex.ir" + tt.path
+		if got := endpointFamily(url); got != tt.want {
+			t.Errorf("endpointFamily(%q) = %q, want %q", url, got, tt.want)
+		}
+	}
+}
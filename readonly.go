@@ -0,0 +1,60 @@
+package nobitex
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// ErrReadOnly is wrapped by errors returned for mutating calls made on a
+// client created with ClientOptions.ReadOnly. Test for it with errors.Is.
+var ErrReadOnly = errors.New("client is read-only")
+
+// readOnlyEndpoints lists the non-GET endpoints that only read data, plus
+// login, and are therefore allowed in read-only mode.
+var readOnlyEndpoints = []string{
+	"/auth/login",
+	"/users/wallets/list",
+	"/users/wallets/deposits/list",
+	"/users/wallets/deposit/shetab/status",
+	"/users/wallets/withdraws/list",
+	"/market/orders/status",
+	"/exchange/get-quote",
+}
+
+// ReadOnly reports whether the client rejects mutating calls.
+func (c *Client) ReadOnly() bool {
+	return c.readOnly
+}
+
+// checkReadOnly rejects mutating requests when the client is read-only.
+// GET, HEAD and OPTIONS requests and the endpoints of readOnlyEndpoints
+// pass; every other request, including unknown endpoints sent through
+// Request, is treated as mutating.
+func (c *Client) checkReadOnly(method string, rawUrl string) error {
+	if !c.readOnly {
+		return nil
+	}
+
+	switch method {
+	case "GET", "HEAD", "OPTIONS":
+		return nil
+	}
+
+	path := rawUrl
+	if parsed, err := url.Parse(rawUrl); err == nil {
+		path = parsed.Path
+	}
+	path = strings.TrimSuffix(path, "/")
+	for _, endpoint := range readOnlyEndpoints {
+		if strings.HasSuffix(path, endpoint) {
+			return nil
+		}
+	}
+
+	return &GoNobitexError{
+		Message: fmt.Sprintf("%s %s not sent", method, path),
+		Err:     ErrReadOnly,
+	}
+}
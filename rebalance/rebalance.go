@@ -0,0 +1,316 @@
+// Package rebalance computes, and optionally places, the orders that move
+// a portfolio back to a set of target weights.
+package rebalance
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/big"
+	"sort"
+	"strings"
+	"time"
+
+	nobitex "github.com/darhelm/go-nobitex"
+	t "github.com/darhelm/go-nobitex/types"
+)
+
+// Exchange is the subset of *nobitex.Client used by a Rebalancer.
+type Exchange interface {
+	PortfolioValue(ctx context.Context, quote string, opts ...nobitex.RequestOption) (*t.PortfolioValuation, error)
+	GetTickers(params t.GetTickersParams, opts ...nobitex.RequestOption) (*t.Tickers, error)
+	GetNobitexConfig(opts ...nobitex.RequestOption) (*t.Config, error)
+	CreateOrder(params t.CreateOrderParams, opts ...nobitex.RequestOption) (*t.OrderStatus, error)
+}
+
+// Rebalancer moves a portfolio towards target weights by trading every
+// asset against a single quote currency.
+//
+// Example:
+//
+//	r := &rebalance.Rebalancer{
+//	    Exchange:     client,
+//	    Quote:        "usdt",
+//	    Targets:      map[string]float64{"btc": 0.5, "eth": 0.3, "usdt": 0.2},
+//	    MaxDeviation: 0.02,
+//	}
+//	plan, err := r.Plan(ctx)
+//	if err != nil {
+//	    return err
+//	}
+//	for _, o := range plan.Orders {
+//	    fmt.Println(o.Side, o.Amount, o.Currency)
+//	}
+//	results := r.Execute(ctx, plan)
+type Rebalancer struct {
+	// Exchange values the portfolio and places the orders.
+	// *nobitex.Client implements it.
+	Exchange Exchange
+
+	// Quote is the currency every asset is valued and traded against,
+	// "usdt" or "rls".
+	Quote string
+
+	// Targets maps currencies to their target share of the portfolio
+	// value, between 0 and 1. The weights must not sum to more than 1;
+	// any remainder is held in Quote. Held currencies missing from
+	// Targets have a target of 0 and are sold.
+	Targets map[string]float64
+
+	// MaxDeviation is the drift, in absolute weight (0.02 = 2 percentage
+	// points), an asset may have before it is traded.
+	MaxDeviation float64
+}
+
+// Order is one trade of a Plan.
+type Order struct {
+	// Currency is the asset traded against the plan's quote currency.
+	Currency string
+
+	// Side is "buy" or "sell".
+	Side string
+
+	// Amount is the base amount, rounded down to the market's step size.
+	Amount string
+
+	// Price is the latest market price the order was sized with.
+	Price string
+
+	// Value is Amount × Price in the quote currency.
+	Value string
+
+	// CurrentWeight and TargetWeight are the asset's share of the
+	// portfolio before and after the trade.
+	CurrentWeight float64
+	TargetWeight  float64
+}
+
+// Skip records an asset that drifted past MaxDeviation but is not traded.
+type Skip struct {
+	// Currency is the asset.
+	Currency string
+
+	// Reason explains why no order was planned.
+	Reason string
+}
+
+// Plan is the outcome of Rebalancer.Plan.
+type Plan struct {
+	// Quote is the quote currency of every order.
+	Quote string
+
+	// Total is the portfolio value the plan was computed from.
+	Total string
+
+	// Orders lists the trades to perform, sells first so that their
+	// proceeds fund the buys.
+	Orders []Order
+
+	// Skipped lists drifted assets left alone.
+	Skipped []Skip
+
+	// At is when the plan was computed.
+	At time.Time
+}
+
+// Result is the outcome of placing one Order.
+type Result struct {
+	// Order is the planned trade.
+	Order Order
+
+	// OrderId is the id of the placed order, zero on failure.
+	OrderId int
+
+	// Err is the placement error, if any.
+	Err error
+}
+
+// Plan values the portfolio and computes the orders that bring every
+// asset drifted by more than MaxDeviation back to its target weight.
+//
+// Behavior:
+//   - Assets are priced with the latest price of their direct market
+//     against Quote; assets without one are skipped.
+//   - Amounts are rounded down to the market's step size from the
+//     exchange configuration.
+//   - Orders worth less than the exchange's minimum order value for
+//     Quote are skipped.
+func (r *Rebalancer) Plan(ctx context.Context) (*Plan, error) {
+	quote := strings.ToLower(r.Quote)
+	if err := r.validate(quote); err != nil {
+		return nil, err
+	}
+
+	valuation, err := r.Exchange.PortfolioValue(ctx, quote)
+	if err != nil {
+		return nil, fmt.Errorf("rebalance: valuing portfolio: %w", err)
+	}
+	if valuation.Total.Sign() <= 0 {
+		return nil, fmt.Errorf("rebalance: portfolio has no priced assets")
+	}
+	tickers, err := r.Exchange.GetTickers(t.GetTickersParams{}, nobitex.WithContext(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("rebalance: fetching tickers: %w", err)
+	}
+	config, err := r.Exchange.GetNobitexConfig(nobitex.WithContext(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("rebalance: fetching config: %w", err)
+	}
+
+	current := make(map[string]*big.Rat)
+	for _, asset := range valuation.Assets {
+		current[asset.Currency] = asset.Value
+	}
+	currencies := make(map[string]bool)
+	for currency := range current {
+		currencies[currency] = true
+	}
+	for currency := range r.Targets {
+		currencies[strings.ToLower(currency)] = true
+	}
+
+	var minValue *big.Rat
+	if minOrder, ok := config.Nobitex.MinOrders[quote]; ok {
+		minValue, _ = new(big.Rat).SetString(minOrder)
+	}
+
+	plan := &Plan{Quote: quote, Total: nobitex.FormatDecimal(valuation.Total), At: time.Now()}
+	for currency := range currencies {
+		if currency == quote {
+			continue
+		}
+
+		value := current[currency]
+		if value == nil {
+			value = new(big.Rat)
+		}
+		weight, _ := new(big.Rat).Quo(value, valuation.Total).Float64()
+		target := r.target(currency)
+		if math.Abs(weight-target) <= r.MaxDeviation {
+			continue
+		}
+		skip := func(format string, args ...any) {
+			plan.Skipped = append(plan.Skipped, Skip{Currency: currency, Reason: fmt.Sprintf(format, args...)})
+		}
+
+		price, ok := nobitex.LatestPrice(tickers, currency+"-"+quote)
+		if !ok {
+			skip("no open %s market", nobitex.MarketSymbol(currency, quote))
+			continue
+		}
+
+		delta := new(big.Rat).Sub(new(big.Rat).Mul(valuation.Total, ratOf(target)), value)
+		side := "buy"
+		if delta.Sign() < 0 {
+			side = "sell"
+			delta.Neg(delta)
+		}
+
+		market := nobitex.MarketSymbol(currency, quote)
+		amount := new(big.Rat).Quo(delta, price)
+		step, ok := config.Nobitex.StepSize(market)
+		if !ok {
+			step, _ = config.Nobitex.StepSize(currency)
+		}
+		amount = nobitex.FloorToStep(amount, step.Step)
+		if amount.Sign() <= 0 {
+			skip("drift is smaller than one amount step")
+			continue
+		}
+
+		orderValue := new(big.Rat).Mul(amount, price)
+		if minValue != nil && orderValue.Cmp(minValue) < 0 {
+			skip("order value %s is below the %s minimum of %s", nobitex.FormatDecimal(orderValue), quote, nobitex.FormatDecimal(minValue))
+			continue
+		}
+
+		plan.Orders = append(plan.Orders, Order{
+			Currency:      currency,
+			Side:          side,
+			Amount:        nobitex.FormatDecimal(amount),
+			Price:         nobitex.FormatDecimal(price),
+			Value:         nobitex.FormatDecimal(orderValue),
+			CurrentWeight: weight,
+			TargetWeight:  target,
+		})
+	}
+
+	sort.Slice(plan.Orders, func(i, j int) bool {
+		if plan.Orders[i].Side != plan.Orders[j].Side {
+			return plan.Orders[i].Side == "sell"
+		}
+		return plan.Orders[i].Currency < plan.Orders[j].Currency
+	})
+	sort.Slice(plan.Skipped, func(i, j int) bool {
+		return plan.Skipped[i].Currency < plan.Skipped[j].Currency
+	})
+	return plan, nil
+}
+
+// Execute places the orders of plan as market orders, in order, and
+// returns one Result per order. A failed order does not stop the
+// remaining ones.
+//
+// Each order carries a ClientOrderId derived from the plan time and
+// currency, which makes CreateOrder retry it on transport errors.
+// Nobitex only refuses a duplicate id while the first order is open, and
+// market orders fill at once, so calling Execute again with the same
+// plan places its orders again; compute a new Plan instead.
+func (r *Rebalancer) Execute(ctx context.Context, plan *Plan) []Result {
+	results := make([]Result, 0, len(plan.Orders))
+	for _, order := range plan.Orders {
+		result := Result{Order: order}
+		status, err := r.Exchange.CreateOrder(t.CreateOrderParams{
+			Type:          order.Side,
+			Execution:     "market",
+			SrcCurrency:   order.Currency,
+			DstCurrency:   plan.Quote,
+			Amount:        order.Amount,
+			ClientOrderId: fmt.Sprintf("rb-%d-%s", plan.At.Unix(), order.Currency),
+		}, nobitex.WithContext(ctx))
+		if err != nil {
+			result.Err = err
+		} else {
+			result.OrderId = status.Order.Id
+		}
+		results = append(results, result)
+	}
+	return results
+}
+
+// validate checks the configuration.
+func (r *Rebalancer) validate(quote string) error {
+	if quote != "usdt" && quote != "rls" {
+		return fmt.Errorf("rebalance: unsupported quote currency %q, use usdt or rls", r.Quote)
+	}
+	if r.MaxDeviation < 0 {
+		return fmt.Errorf("rebalance: negative MaxDeviation")
+	}
+
+	var sum float64
+	for currency, weight := range r.Targets {
+		if weight < 0 || weight > 1 {
+			return fmt.Errorf("rebalance: target weight of %s must be between 0 and 1, got %v", currency, weight)
+		}
+		sum += weight
+	}
+	if sum > 1+1e-9 {
+		return fmt.Errorf("rebalance: target weights sum to %v, more than 1", sum)
+	}
+	return nil
+}
+
+// target returns the target weight of currency, zero when unset.
+func (r *Rebalancer) target(currency string) float64 {
+	for key, weight := range r.Targets {
+		if strings.EqualFold(key, currency) {
+			return weight
+		}
+	}
+	return 0
+}
+
+// ratOf converts a weight to a Rat.
+func ratOf(f float64) *big.Rat {
+	return new(big.Rat).SetFloat64(f)
+}
@@ -0,0 +1,243 @@
+package nobitex
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	t "github.com/darhelm/go-nobitex/types"
+)
+
+// orderNotFoundCodes lists the API error codes Nobitex answers an order
+// status lookup with when it does not know the order.
+var orderNotFoundCodes = []string{"NotFound", "OrderNotFound"}
+
+// DiscrepancyType classifies a difference between the locally tracked
+// orders and the exchange.
+type DiscrepancyType string
+
+const (
+	// DiscrepancyUnknownRemote is an open order on the exchange that the
+	// client does not track, e.g. one placed before a crash or by another
+	// process.
+	DiscrepancyUnknownRemote DiscrepancyType = "unknown_remote"
+
+	// DiscrepancyStale is a tracked order whose local state lags behind
+	// the exchange: it was filled, canceled or partially matched without
+	// the client noticing.
+	DiscrepancyStale DiscrepancyType = "stale"
+
+	// DiscrepancyMissing is a tracked order the exchange no longer knows.
+	DiscrepancyMissing DiscrepancyType = "missing"
+)
+
+// Discrepancy is one difference found by a Reconciler.
+type Discrepancy struct {
+	// Type classifies the difference.
+	Type DiscrepancyType
+
+	// OrderId identifies the order.
+	OrderId int
+
+	// Local is the tracked state; zero for DiscrepancyUnknownRemote.
+	Local t.OrderStatusResponse
+
+	// Remote is the exchange state; zero for DiscrepancyMissing.
+	Remote t.OrderStatusResponse
+
+	// Repaired reports whether the Reconciler fixed the local state.
+	Repaired bool
+}
+
+// Reconciler periodically compares the orders tracked by a client (see
+// Client.Events, RestoreOrders and WatchOrders) with the open orders on
+// the exchange, so bots can recover from crashes and missed updates.
+//
+// Example:
+//
+//	if _, err := client.RestoreOrders(ctx); err != nil {
+//	    return err
+//	}
+//	r := &nobitex.Reconciler{
+//	    Client: client, Adopt: true, Repair: true,
+//	    OnDiscrepancy: func(d nobitex.Discrepancy) {
+//	        log.Printf("order %d: %s", d.OrderId, d.Type)
+//	    },
+//	}
+//	go r.Run(ctx, time.Minute)
+type Reconciler struct {
+	// Client is the client whose tracked orders are reconciled.
+	Client *Client
+
+	// Adopt starts tracking unknown remote open orders.
+	Adopt bool
+
+	// Repair applies the exchange state to stale and missing orders:
+	// finished orders are untracked and the matching OrderFilled,
+	// OrderCanceled or OrderPartiallyFilled events are published.
+	Repair bool
+
+	// OnDiscrepancy, if set, receives every discrepancy as it is found.
+	OnDiscrepancy func(Discrepancy)
+
+	// OnError, if set, receives the errors of the passes made by Run.
+	OnError func(err error)
+}
+
+// Reconcile performs one comparison and returns the discrepancies found,
+// sorted by order id.
+//
+// Behavior:
+//   - Open orders are listed from the exchange; tracked orders that are
+//     not open remotely, or whose unmatched amount differs, are looked
+//     up with GetOrderStatus.
+//   - An order the exchange reports as unknown (HTTP 404 or a not-found
+//     error code) is a DiscrepancyMissing.
+//   - Other lookup failures, including other 400 responses, skip the
+//     order and are returned joined with the discrepancies found so far;
+//     the listing failing aborts the pass.
+func (r *Reconciler) Reconcile(ctx context.Context) ([]Discrepancy, error) {
+	c := r.Client
+
+	remote := make(map[int]t.OrdersListResponse)
+	for order, err := range c.Orders(ctx, t.GetOrdersListParams{Status: "open"}) {
+		if err != nil {
+			return nil, err
+		}
+		remote[order.Id] = order
+	}
+
+	local := make(map[int]t.OrderStatusResponse)
+	for _, order := range c.events.tracked() {
+		local[order.Id] = order
+	}
+
+	var (
+		found []Discrepancy
+		errs  []error
+	)
+	report := func(d Discrepancy) {
+		found = append(found, d)
+		if r.OnDiscrepancy != nil {
+			safeHook("reconciler", c.onPanic, func() { r.OnDiscrepancy(d) })
+		}
+	}
+
+	for id := range remote {
+		if _, ok := local[id]; ok {
+			continue
+		}
+
+		status, err := c.GetOrderStatus(t.GetOrderStatusParams{Id: id}, WithContext(ctx))
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		d := Discrepancy{Type: DiscrepancyUnknownRemote, OrderId: id, Remote: status.Order}
+		if r.Adopt {
+			c.events.track(status.Order)
+			d.Repaired = true
+		}
+		report(d)
+	}
+
+	for id, order := range local {
+		if open, ok := remote[id]; ok && sameUnmatched(order.UnmatchedAmount, unmatchedAmount(open)) {
+			continue
+		}
+
+		status, err := c.GetOrderStatus(t.GetOrderStatusParams{Id: id}, WithContext(ctx))
+		if err != nil {
+			if !isOrderNotFound(err) {
+				errs = append(errs, err)
+				continue
+			}
+
+			d := Discrepancy{Type: DiscrepancyMissing, OrderId: id, Local: order}
+			if r.Repair {
+				c.events.untrack(id)
+				d.Repaired = true
+			}
+			report(d)
+			continue
+		}
+
+		if strings.EqualFold(status.Order.Status, order.Status) && sameAmount(status.Order.UnmatchedAmount, order.UnmatchedAmount) {
+			continue
+		}
+		d := Discrepancy{Type: DiscrepancyStale, OrderId: id, Local: order, Remote: status.Order}
+		if r.Repair {
+			c.publishOrderState(order, status.Order)
+			d.Repaired = true
+		}
+		report(d)
+	}
+
+	sort.Slice(found, func(i, j int) bool { return found[i].OrderId < found[j].OrderId })
+	return found, errors.Join(errs...)
+}
+
+// Run reconciles every interval until ctx is done or the client is
+// closed, returning ctx.Err() or ErrClientClosed. Failed passes are
+// reported to OnError and retried on the next tick.
+func (r *Reconciler) Run(ctx context.Context, interval time.Duration) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-r.Client.Done():
+			return ErrClientClosed
+		case <-ticker.C:
+		}
+
+		if _, err := r.Reconcile(ctx); err != nil && r.OnError != nil {
+			safeHook("reconciler", r.Client.onPanic, func() { r.OnError(err) })
+		}
+	}
+}
+
+// sameUnmatched compares two decimal amounts numerically; malformed
+// amounts compare by text.
+func sameUnmatched(local string, remote *big.Rat) bool {
+	amount, ok := new(big.Rat).SetString(local)
+	if !ok {
+		return local == remote.RatString()
+	}
+	return amount.Cmp(remote) == 0
+}
+
+// sameAmount compares two decimal strings numerically, so "0.10" equals
+// "0.1"; malformed amounts compare by text.
+func sameAmount(a, b string) bool {
+	x, okA := new(big.Rat).SetString(a)
+	y, okB := new(big.Rat).SetString(b)
+	if !okA || !okB {
+		return a == b
+	}
+	return x.Cmp(y) == 0
+}
+
+// isOrderNotFound reports whether err is the exchange saying an order does
+// not exist, as opposed to rejecting the lookup itself.
+func isOrderNotFound(err error) bool {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	if apiErr.StatusCode == http.StatusNotFound {
+		return true
+	}
+	for _, code := range orderNotFoundCodes {
+		if apiErr.Code == code {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,44 @@
+package nobitex
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/darhelm/go-nobitex/types"
+)
+
+func TestReconcileLookupOutcomes(t *testing.T) {
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/market/orders/list" {
+			_, _ = w.Write([]byte(`{"status":"ok","orders":[],"hasNext":false}`))
+			return
+		}
+
+		var params types.GetOrderStatusParams
+		_ = json.NewDecoder(r.Body).Decode(&params)
+		switch params.Id {
+		case 1:
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"status":"failed","code":"InvalidRequest","message":"throttled lookup"}`))
+		case 2:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"status":"failed","code":"NotFound","message":"order not found"}`))
+		case 3:
+			_, _ = w.Write([]byte(`{"status":"ok","order":{"id":3,"status":"Active","unmatchedAmount":"0.10"}}`))
+		}
+	}), ClientOptions{})
+
+	c.events.track(types.OrderStatusResponse{Id: 1, Status: "Active", UnmatchedAmount: "1"})
+	c.events.track(types.OrderStatusResponse{Id: 2, Status: "Active", UnmatchedAmount: "1"})
+	c.events.track(types.OrderStatusResponse{Id: 3, Status: "Active", UnmatchedAmount: "0.1"})
+
+	found, err := (&Reconciler{Client: c}).Reconcile(context.Background())
+	if err == nil {
+		t.Fatal("the failed lookup of order 1 was not reported")
+	}
+	if len(found) != 1 || found[0].OrderId != 2 || found[0].Type != DiscrepancyMissing {
+		t.Fatalf("discrepancies = %+v, want only order 2 missing", found)
+	}
+}
@@ -0,0 +1,60 @@
+package nobitex
+
+import (
+	"fmt"
+	"io"
+	"runtime/debug"
+)
+
+// PanicError is returned (or reported through ClientOptions.OnPanic) in
+// place of a panic raised while decoding a response or running a user
+// callback, so one malformed payload or faulty hook cannot bring down a
+// long-running process.
+type PanicError struct {
+	// Where names the recovered code path, e.g. "response handler".
+	Where string
+
+	// Value is the value passed to panic.
+	Value any
+
+	// Stack is the stack trace captured at recovery.
+	Stack []byte
+}
+
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("panic in %s: %v", e.Where, e.Value)
+}
+
+// Unwrap exposes the panic value when it was itself an error.
+func (e *PanicError) Unwrap() error {
+	err, _ := e.Value.(error)
+	return err
+}
+
+// recoverPanic converts a panic in the deferring function into a
+// *PanicError stored in *err. It must be called directly by defer.
+func recoverPanic(where string, err *error) {
+	if r := recover(); r != nil {
+		*err = &PanicError{Where: where, Value: r, Stack: debug.Stack()}
+	}
+}
+
+// callHandle runs a response handler, recovering panics raised by the
+// decoder or by user-supplied streaming callbacks.
+func callHandle(handle func(r io.Reader) error, r io.Reader) (err error) {
+	defer recoverPanic("response handler", &err)
+	return handle(r)
+}
+
+// safeHook runs a callback whose result cannot be returned to a caller.
+// A panic is recovered and passed to onPanic, if set.
+func safeHook(where string, onPanic func(error), fn func()) {
+	var err error
+	func() {
+		defer recoverPanic(where, &err)
+		fn()
+	}()
+	if err != nil && onPanic != nil {
+		onPanic(err)
+	}
+}
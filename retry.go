@@ -0,0 +1,169 @@
+package nobitex
+
+import (
+	"errors"
+	"math"
+	"math/rand/v2"
+	"net/http"
+	"time"
+)
+
+// JitterStrategy selects how retry delays are randomized.
+type JitterStrategy int
+
+const (
+	// JitterFull waits a random delay in [0, backoff]. It spreads retries
+	// of many clients the most and is the recommended default for fleets.
+	JitterFull JitterStrategy = iota
+
+	// JitterEqual waits backoff/2 plus a random delay in [0, backoff/2],
+	// guaranteeing a minimum pause while still desynchronizing clients.
+	JitterEqual
+
+	// JitterNone waits exactly the exponential backoff.
+	JitterNone
+)
+
+// Defaults applied to zero fields of RetryPolicy.
+const (
+	DefaultRetryInitialBackoff = 500 * time.Millisecond
+	DefaultRetryMaxBackoff     = 30 * time.Second
+	DefaultRetryMultiplier     = 2.0
+)
+
+// RetryPolicy configures automatic retries of failed API calls. The zero
+// value disables retries.
+//
+// Only calls that cannot cause a duplicate side effect are retried:
+// GET/HEAD/OPTIONS/PUT/DELETE requests, read-only POST endpoints, orders
+// carrying a ClientOrderId, and calls marked with WithIdempotent. Other
+// calls, such as orders without a ClientOrderId, conversions, loans and
+// staking, always run exactly once.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts per call, including the
+	// first. Values below 2 disable retries.
+	MaxAttempts int
+
+	// InitialBackoff is the delay before the first retry.
+	// Defaults to DefaultRetryInitialBackoff.
+	InitialBackoff time.Duration
+
+	// MaxBackoff caps the delay between two attempts.
+	// Defaults to DefaultRetryMaxBackoff.
+	MaxBackoff time.Duration
+
+	// Multiplier grows the delay after every retry.
+	// Defaults to DefaultRetryMultiplier.
+	Multiplier float64
+
+	// Jitter randomizes every delay. Defaults to JitterFull.
+	Jitter JitterStrategy
+
+	// MaxElapsedTime bounds the total time spent on one call, including
+	// every attempt and wait. No retry is started that would begin after
+	// it has passed. Zero means no limit.
+	MaxElapsedTime time.Duration
+}
+
+// backoff returns the delay to wait before retry number attempt (0-based).
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	initial := p.InitialBackoff
+	if initial <= 0 {
+		initial = DefaultRetryInitialBackoff
+	}
+	maxBackoff := p.MaxBackoff
+	if maxBackoff <= 0 {
+		maxBackoff = DefaultRetryMaxBackoff
+	}
+	multiplier := p.Multiplier
+	if multiplier < 1 {
+		multiplier = DefaultRetryMultiplier
+	}
+
+	delay := float64(initial) * math.Pow(multiplier, float64(attempt))
+	if delay > float64(maxBackoff) {
+		delay = float64(maxBackoff)
+	}
+	d := time.Duration(delay)
+
+	switch p.Jitter {
+	case JitterNone:
+		return d
+	case JitterEqual:
+		half := d / 2
+		return half + randDuration(d-half)
+	default:
+		return randDuration(d)
+	}
+}
+
+// randDuration returns a random duration in [0, d].
+func randDuration(d time.Duration) time.Duration {
+	if d <= 0 {
+		return 0
+	}
+	return time.Duration(rand.Int64N(int64(d) + 1))
+}
+
+// withRetry runs call until it succeeds, fails with a non-retryable error,
+// or the retry budget (attempts and elapsed time) is exhausted.
+//
+// Behavior:
+//   - The policy set through WithRetryPolicy overrides ClientOptions.Retry.
+//   - Non-idempotent calls not marked WithIdempotent run once.
+//   - Rate limiting (429), server errors (5xx) and transport failures are
+//     retried; other API errors are returned immediately. Empty 2xx
+//     responses are only retried for idempotent HTTP methods.
+//   - Waits are interrupted by the caller's context.
+func (c *Client) withRetry(method string, ro *requestOptions, call func() error) error {
+	policy := c.retry
+	if ro.retry != nil {
+		policy = *ro.retry
+	}
+	if policy.MaxAttempts < 2 {
+		return call()
+	}
+	idempotent := isIdempotent(method) || ro.idempotent
+	if !idempotent {
+		return call()
+	}
+
+	start := time.Now()
+	var err error
+	for attempt := 0; attempt < policy.MaxAttempts; attempt++ {
+		if attempt > 0 {
+			delay := policy.backoff(attempt - 1)
+			if policy.MaxElapsedTime > 0 && time.Since(start)+delay >= policy.MaxElapsedTime {
+				return err
+			}
+			if waitErr := sleepContext(ro.ctx, delay); waitErr != nil {
+				return err
+			}
+			c.count(CounterRetries, &c.counters.retries)
+		}
+
+		err = call()
+		if err == nil || ro.ctx.Err() != nil || !isRetryableError(err, isIdempotent(method)) {
+			return err
+		}
+	}
+	return err
+}
+
+// isRetryableError reports whether a failed call may succeed when repeated.
+// An empty successful response means the server processed the request, so
+// it is only retried when the HTTP method is idempotent; a ClientOrderId or
+// WithIdempotent does not make resending a processed POST safe.
+func isRetryableError(err error, idempotentMethod bool) bool {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode == http.StatusTooManyRequests || apiErr.StatusCode >= 500
+	}
+
+	if errors.Is(err, ErrEmptyResponse) {
+		return idempotentMethod
+	}
+
+	var reqErr *RequestError
+	return errors.As(err, &reqErr) && reqErr.Operation == "sending request"
+}
@@ -0,0 +1,128 @@
+package nobitex
+
+import (
+	"errors"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/darhelm/go-nobitex/types"
+)
+
+// failingServer answers every request with a 503 and counts them.
+func failingServer(hits *atomic.Int32) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusServiceUnavailable)
+		_, _ = w.Write([]byte(`{"status":"failed","code":"ServerError","message":"try again"}`))
+	})
+}
+
+func TestRetryOnlyIdempotentCalls(t *testing.T) {
+	tests := []struct {
+		name   string
+		method string
+		opts   []RequestOption
+		want   int32
+	}{
+		{"get is retried", "GET", nil, 3},
+		{"post runs once", "POST", nil, 1},
+		{"idempotent post is retried", "POST", []RequestOption{WithIdempotent()}, 3},
+		{"per-call policy cannot retry post", "POST", []RequestOption{WithRetryPolicy(RetryPolicy{MaxAttempts: 5})}, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var hits atomic.Int32
+			c := newTestClient(t, failingServer(&hits), ClientOptions{
+				Retry: RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond, Jitter: JitterNone},
+			})
+
+			var out map[string]any
+			if err := c.ApiRequest(tt.method, "/market/orders/add", "", true, false, map[string]string{"a": "b"}, &out, tt.opts...); err == nil {
+				t.Fatal("expected an error")
+			}
+			if got := hits.Load(); got != tt.want {
+				t.Fatalf("attempts = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	p := RetryPolicy{InitialBackoff: 100 * time.Millisecond, MaxBackoff: time.Second, Multiplier: 2, Jitter: JitterNone}
+	want := []time.Duration{100 * time.Millisecond, 200 * time.Millisecond, 400 * time.Millisecond, 800 * time.Millisecond, time.Second}
+	for attempt, w := range want {
+		if got := p.backoff(attempt); got != w {
+			t.Errorf("backoff(%d) = %s, want %s", attempt, got, w)
+		}
+	}
+}
+
+func TestIsRetryableError(t *testing.T) {
+	tests := []struct {
+		name       string
+		err        error
+		idempotent bool
+		want       bool
+	}{
+		{"429", &APIError{StatusCode: http.StatusTooManyRequests}, true, true},
+		{"500", &APIError{StatusCode: http.StatusInternalServerError}, true, true},
+		{"400", &APIError{StatusCode: http.StatusBadRequest}, true, false},
+		{"empty response get", decodeError(ErrEmptyResponse), true, true},
+		{"empty response post", decodeError(ErrEmptyResponse), false, false},
+		{"send failure", &RequestError{Operation: "sending request"}, true, true},
+		{"read failure", &RequestError{Operation: "reading response"}, true, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isRetryableError(tt.err, tt.idempotent); got != tt.want {
+				t.Fatalf("isRetryableError = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEmptyResponseNotRetriedForOrders(t *testing.T) {
+	var hits atomic.Int32
+	empty := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+	})
+	c := newTestClient(t, empty, ClientOptions{
+		Retry: RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond, Jitter: JitterNone},
+	})
+
+	_, err := c.CreateOrder(types.CreateOrderParams{
+		Type: "buy", Execution: "market",
+		SrcCurrency: "btc", DstCurrency: "usdt",
+		Amount: "0.01", ClientOrderId: "order-1",
+	})
+	if !errors.Is(err, ErrEmptyResponse) {
+		t.Fatalf("err = %v, want ErrEmptyResponse", err)
+	}
+	if got := hits.Load(); got != 1 {
+		t.Fatalf("attempts = %d, want 1", got)
+	}
+}
+
+func TestEmptyResponseRetriedForGet(t *testing.T) {
+	var hits atomic.Int32
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if hits.Add(1) < 3 {
+			return
+		}
+		_, _ = w.Write([]byte(`{"status":"ok"}`))
+	}), ClientOptions{
+		Retry: RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond, Jitter: JitterNone},
+	})
+
+	var out map[string]any
+	if err := c.ApiRequest("GET", "/market/stats", "", false, false, nil, &out); err != nil {
+		t.Fatal(err)
+	}
+	if got := hits.Load(); got != 3 {
+		t.Fatalf("attempts = %d, want 3", got)
+	}
+}
@@ -0,0 +1,275 @@
+package nobitex
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+	"time"
+
+	t "github.com/darhelm/go-nobitex/types"
+)
+
+// ErrRiskLimit is wrapped by every *RiskError. Test for it with errors.Is.
+var ErrRiskLimit = errors.New("pre-trade risk limit violated")
+
+// RiskRule identifies the limit a RiskError violated.
+type RiskRule string
+
+const (
+	// RiskBannedSymbol rejects orders in a market listed in
+	// RiskLimits.BannedSymbols.
+	RiskBannedSymbol RiskRule = "banned_symbol"
+
+	// RiskMaxNotional rejects orders worth more than
+	// RiskLimits.MaxOrderNotional.
+	RiskMaxNotional RiskRule = "max_order_notional"
+
+	// RiskMaxOpenOrders rejects orders that would exceed
+	// RiskLimits.MaxOpenOrdersPerMarket.
+	RiskMaxOpenOrders RiskRule = "max_open_orders"
+
+	// RiskMaxDailyVolume rejects orders that would push the day's placed
+	// value past RiskLimits.MaxDailyVolume.
+	RiskMaxDailyVolume RiskRule = "max_daily_volume"
+)
+
+// RiskLimits configures the pre-trade checks CreateOrder runs before an
+// order is sent. The zero value disables every check.
+//
+// Example:
+//
+//	RiskLimits: nobitex.RiskLimits{
+//	    MaxOrderNotional:       map[string]string{"usdt": "5000", "rls": "2000000000"},
+//	    MaxOpenOrdersPerMarket: 10,
+//	    MaxDailyVolume:         map[string]string{"usdt": "50000"},
+//	    BannedSymbols:          []string{"SHIBIRT"},
+//	}
+type RiskLimits struct {
+	// MaxOrderNotional caps Amount × Price of a single order, keyed by
+	// quote currency ("usdt", "rls"). Market orders are valued at the
+	// market's latest price.
+	MaxOrderNotional map[string]string
+
+	// MaxOpenOrdersPerMarket caps the open orders of one market, counted
+	// from the exchange before each order. Zero disables the check.
+	MaxOpenOrdersPerMarket int
+
+	// MaxDailyVolume caps the summed notional of the orders placed through
+	// this client and its clones since midnight UTC, keyed by quote
+	// currency. An order holds its share while in flight and gives it back
+	// if it fails. The tally is kept in memory and restarts with the
+	// process.
+	MaxDailyVolume map[string]string
+
+	// BannedSymbols lists markets, e.g. "SHIBIRT", no order may be placed
+	// in.
+	BannedSymbols []string
+}
+
+// enabled reports whether any check is configured.
+func (l RiskLimits) enabled() bool {
+	return len(l.MaxOrderNotional) > 0 || l.MaxOpenOrdersPerMarket > 0 ||
+		len(l.MaxDailyVolume) > 0 || len(l.BannedSymbols) > 0
+}
+
+// RiskError is returned by CreateOrder when an order violates a
+// RiskLimits check. The order is not sent.
+type RiskError struct {
+	GoNobitexError
+
+	// Rule is the violated limit.
+	Rule RiskRule
+
+	// Market is the order's market symbol, e.g. "BTCUSDT".
+	Market string
+
+	// Limit and Value are the configured limit and the value that broke
+	// it; empty for RiskBannedSymbol.
+	Limit string
+	Value string
+}
+
+// riskState holds the daily volume tally. Clones share it by pointer.
+type riskState struct {
+	mu     sync.Mutex
+	day    time.Time
+	volume map[string]*big.Rat
+}
+
+// riskReservation is the share of the daily tally an order in flight
+// holds until it is placed or fails.
+type riskReservation struct {
+	quote    string
+	day      time.Time
+	notional *big.Rat
+}
+
+// today returns the tally of the current UTC day, resetting it at
+// midnight. The caller must hold mu.
+func (s *riskState) today() map[string]*big.Rat {
+	day := time.Now().UTC().Truncate(24 * time.Hour)
+	if !day.Equal(s.day) || s.volume == nil {
+		s.day = day
+		s.volume = make(map[string]*big.Rat)
+	}
+	return s.volume
+}
+
+// reserve adds notional to the quote's tally if the total stays within
+// limit, and returns the new total either way. Checking and adding under
+// one lock keeps concurrent orders from all passing against the same
+// tally.
+func (s *riskState) reserve(quote string, notional, limit *big.Rat) (*riskReservation, *big.Rat) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	volume := s.today()
+	total := new(big.Rat).Set(notional)
+	if placed := volume[quote]; placed != nil {
+		total.Add(total, placed)
+	}
+	if total.Cmp(limit) > 0 {
+		return nil, total
+	}
+
+	volume[quote] = total
+	return &riskReservation{quote: quote, day: s.day, notional: notional}, total
+}
+
+// release returns a reservation to the tally after its order failed.
+// Reservations from a previous day are dropped with that day's tally.
+func (s *riskState) release(r *riskReservation) {
+	if r == nil {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	volume := s.today()
+	if !s.day.Equal(r.day) || volume[r.quote] == nil {
+		return
+	}
+	volume[r.quote].Sub(volume[r.quote], r.notional)
+}
+
+// riskError builds a RiskError for rule.
+func riskError(rule RiskRule, market, limit, value string, format string, args ...any) *RiskError {
+	return &RiskError{
+		GoNobitexError: GoNobitexError{
+			Message: fmt.Sprintf(format, args...),
+			Err:     ErrRiskLimit,
+		},
+		Rule:   rule,
+		Market: market,
+		Limit:  limit,
+		Value:  value,
+	}
+}
+
+// checkRisk runs the configured pre-trade checks on params. When a daily
+// volume limit applies, the order's notional is reserved in the tally and
+// the reservation is returned; CreateOrder releases it if the order fails.
+func (c *Client) checkRisk(params t.CreateOrderParams, opts []RequestOption) (*riskReservation, error) {
+	limits := c.risk
+	if !limits.enabled() {
+		return nil, nil
+	}
+
+	quote := strings.ToLower(params.DstCurrency)
+	if quote == "irt" {
+		quote = "rls"
+	}
+	market := MarketSymbol(params.SrcCurrency, quote)
+
+	for _, banned := range limits.BannedSymbols {
+		if normalized, err := NormalizeSymbol(banned); err == nil && normalized == market {
+			return nil, riskError(RiskBannedSymbol, market, "", "", "trading %s is banned", market)
+		}
+	}
+
+	var notional *big.Rat
+	maxNotional, hasMaxNotional := limits.MaxOrderNotional[quote]
+	maxDaily, hasMaxDaily := limits.MaxDailyVolume[quote]
+	if hasMaxNotional || hasMaxDaily {
+		var err error
+		notional, err = c.orderNotional(params, quote, opts)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if hasMaxNotional {
+		limit, err := parseDecimal(quote+" notional limit", maxNotional)
+		if err != nil {
+			return nil, err
+		}
+		if notional.Cmp(limit) > 0 {
+			return nil, riskError(RiskMaxNotional, market, maxNotional, FormatDecimal(notional),
+				"order value %s %s exceeds the limit of %s", FormatDecimal(notional), quote, maxNotional)
+		}
+	}
+
+	if limits.MaxOpenOrdersPerMarket > 0 {
+		ro := resolveRequestOptions(opts)
+		open := 0
+		filter := t.GetOrdersListParams{Status: "open", SrcCurrency: params.SrcCurrency, DstCurrency: params.DstCurrency}
+		for _, err := range c.Orders(ro.ctx, filter) {
+			if err != nil {
+				return nil, err
+			}
+			open++
+			if open >= limits.MaxOpenOrdersPerMarket {
+				return nil, riskError(RiskMaxOpenOrders, market, fmt.Sprint(limits.MaxOpenOrdersPerMarket), fmt.Sprint(open),
+					"%s already has %d open orders", market, open)
+			}
+		}
+	}
+
+	// Reserved last, so no earlier check can fail with a reservation held
+	if hasMaxDaily {
+		limit, err := parseDecimal(quote+" daily volume limit", maxDaily)
+		if err != nil {
+			return nil, err
+		}
+
+		reservation, total := c.riskState.reserve(quote, notional, limit)
+		if reservation == nil {
+			return nil, riskError(RiskMaxDailyVolume, market, maxDaily, FormatDecimal(total),
+				"daily %s volume would reach %s, above the limit of %s", quote, FormatDecimal(total), maxDaily)
+		}
+		return reservation, nil
+	}
+
+	return nil, nil
+}
+
+// orderNotional returns Amount × Price of params, pricing orders without
+// a limit price at the market's latest price.
+func (c *Client) orderNotional(params t.CreateOrderParams, quote string, opts []RequestOption) (*big.Rat, error) {
+	amount, err := parseDecimal("amount", params.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	if params.Price != "" {
+		price, err := parseDecimal("price", params.Price)
+		if err != nil {
+			return nil, err
+		}
+		return amount.Mul(amount, price), nil
+	}
+
+	src := strings.ToLower(params.SrcCurrency)
+	tickers, err := c.GetTickers(t.GetTickersParams{SrcCurrency: src, DstCurrency: quote}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	price, ok := LatestPrice(tickers, src+"-"+quote)
+	if !ok {
+		return nil, &GoNobitexError{Message: fmt.Sprintf("no price to value %s order", MarketSymbol(src, quote))}
+	}
+	return amount.Mul(amount, price), nil
+}
@@ -0,0 +1,138 @@
+package nobitex
+
+import (
+	"errors"
+	"math/big"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	t "github.com/darhelm/go-nobitex/types"
+)
+
+// orderServer accepts every order after delay, or rejects every order
+// with a 400 when reject is set.
+func orderServer(delay time.Duration, reject bool, placed *atomic.Int32) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(delay)
+		if reject {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"status":"failed","code":"InvalidOrderPrice","message":"bad price"}`))
+			return
+		}
+		id := placed.Add(1)
+		_, _ = w.Write([]byte(`{"status":"ok","order":{"id":` + big.NewInt(int64(id)).String() + `,"amount":"1"}}`))
+	})
+}
+
+func usdtOrder() t.CreateOrderParams {
+	return t.CreateOrderParams{
+		Execution:   "limit",
+		SrcCurrency: "btc",
+		DstCurrency: "usdt",
+		Type:        "buy",
+		Amount:      "1",
+		Price:       "10",
+	}
+}
+
+func dailyTally(c *Client, quote string) *big.Rat {
+	c.riskState.mu.Lock()
+	defer c.riskState.mu.Unlock()
+
+	if volume := c.riskState.today()[quote]; volume != nil {
+		return new(big.Rat).Set(volume)
+	}
+	return new(big.Rat)
+}
+
+func TestDailyVolumeReservedConcurrently(t *testing.T) {
+	var placed atomic.Int32
+	c := newTestClient(t, orderServer(20*time.Millisecond, false, &placed), ClientOptions{
+		RiskLimits: RiskLimits{MaxDailyVolume: map[string]string{"usdt": "100"}},
+	})
+
+	var wg sync.WaitGroup
+	var rejected atomic.Int32
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := c.CreateOrder(usdtOrder())
+			var riskErr *RiskError
+			if errors.As(err, &riskErr) && riskErr.Rule == RiskMaxDailyVolume {
+				rejected.Add(1)
+			} else if err != nil {
+				t.Errorf("CreateOrder: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if placed.Load() != 10 || rejected.Load() != 10 {
+		t.Fatalf("placed %d, rejected %d; want 10 and 10", placed.Load(), rejected.Load())
+	}
+	if got := dailyTally(c, "usdt"); got.Cmp(big.NewRat(100, 1)) != 0 {
+		t.Fatalf("tally = %s, want 100", got.FloatString(2))
+	}
+}
+
+func TestDailyVolumeReleasedOnFailure(t *testing.T) {
+	var placed atomic.Int32
+	c := newTestClient(t, orderServer(0, true, &placed), ClientOptions{
+		RiskLimits: RiskLimits{MaxDailyVolume: map[string]string{"usdt": "100"}},
+	})
+
+	if _, err := c.CreateOrder(usdtOrder()); err == nil {
+		t.Fatal("CreateOrder succeeded against a rejecting server")
+	}
+	if got := dailyTally(c, "usdt"); got.Sign() != 0 {
+		t.Fatalf("tally = %s after a failed order, want 0", got.FloatString(2))
+	}
+}
+
+func TestCloneSharesDailyVolume(t *testing.T) {
+	var placed atomic.Int32
+	c := newTestClient(t, orderServer(0, false, &placed), ClientOptions{
+		RiskLimits: RiskLimits{MaxDailyVolume: map[string]string{"usdt": "15"}},
+	})
+	clone, err := c.Clone(Credentials{ApiKey: "second-key"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer clone.Close()
+
+	if _, err := c.CreateOrder(usdtOrder()); err != nil {
+		t.Fatalf("CreateOrder: %v", err)
+	}
+	if _, err := clone.CreateOrder(usdtOrder()); !errors.Is(err, ErrRiskLimit) {
+		t.Fatalf("clone CreateOrder error = %v, want ErrRiskLimit", err)
+	}
+}
+
+func TestRiskRejectionPublishesEvent(t *testing.T) {
+	var placed atomic.Int32
+	c := newTestClient(t, orderServer(0, false, &placed), ClientOptions{
+		RiskLimits: RiskLimits{BannedSymbols: []string{"btcusdt"}},
+	})
+
+	var events []OrderEvent
+	c.Events().Subscribe(func(ev OrderEvent) { events = append(events, ev) })
+
+	params := usdtOrder()
+	params.ClientOrderId = "banned-1"
+	_, err := c.CreateOrder(params)
+
+	var riskErr *RiskError
+	if !errors.As(err, &riskErr) || riskErr.Rule != RiskBannedSymbol {
+		t.Fatalf("CreateOrder error = %v, want a banned symbol RiskError", err)
+	}
+	if placed.Load() != 0 {
+		t.Fatal("banned order was sent")
+	}
+	if len(events) != 1 || events[0].Type != OrderRejected || events[0].ClientOrderId != "banned-1" || events[0].Err != err {
+		t.Fatalf("events = %+v, want one OrderRejected carrying the RiskError", events)
+	}
+}
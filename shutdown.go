@@ -0,0 +1,125 @@
+package nobitex
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// ErrClientClosed is returned by calls made after Close or Shutdown.
+var ErrClientClosed = errors.New("client is closed")
+
+// DefaultShutdownTimeout bounds how long Close waits for in-flight
+// requests to finish.
+const DefaultShutdownTimeout = 10 * time.Second
+
+// lifecycle tracks in-flight requests and signals background goroutines
+// to stop when the client shuts down.
+type lifecycle struct {
+	mu       sync.Mutex
+	closed   bool
+	inflight sync.WaitGroup
+
+	doneOnce sync.Once
+	done     chan struct{}
+}
+
+// doneChan returns the channel closed on shutdown.
+func (l *lifecycle) doneChan() chan struct{} {
+	l.doneOnce.Do(func() {
+		l.done = make(chan struct{})
+	})
+	return l.done
+}
+
+// begin registers an in-flight request. It reports false once the client
+// is closed.
+func (l *lifecycle) begin() bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.closed {
+		return false
+	}
+	l.inflight.Add(1)
+	return true
+}
+
+// end marks an in-flight request as finished.
+func (l *lifecycle) end() {
+	l.inflight.Done()
+}
+
+// Done returns a channel that is closed when the client shuts down.
+// Long-running helpers built on the client can select on it to stop.
+func (c *Client) Done() <-chan struct{} {
+	return c.lifecycle.doneChan()
+}
+
+// Shutdown gracefully stops the client.
+//
+// Behavior:
+//   - New calls fail immediately with an error wrapping ErrClientClosed.
+//   - Background goroutines (StartConfigRefresh, WatchOrders, the
+//     maintenance probe) are signaled to stop.
+//   - Waits for in-flight requests until they finish or ctx is done.
+//   - Closes idle keep-alive connections of the HTTP client.
+//
+// Returns:
+//   - nil when every in-flight request finished.
+//   - ctx.Err() when the deadline passed first; those requests keep
+//     running until their own contexts end.
+//
+// Example:
+//
+//	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+//	defer cancel()
+//	if err := client.Shutdown(ctx); err != nil {
+//	    log.Printf("shutdown: %v", err)
+//	}
+func (c *Client) Shutdown(ctx context.Context) error {
+	c.lifecycle.mu.Lock()
+	alreadyClosed := c.lifecycle.closed
+	c.lifecycle.closed = true
+	c.lifecycle.mu.Unlock()
+
+	if !alreadyClosed {
+		close(c.lifecycle.doneChan())
+	}
+
+	drained := make(chan struct{})
+	go func() {
+		c.lifecycle.inflight.Wait()
+		close(drained)
+	}()
+
+	var err error
+	select {
+	case <-drained:
+	case <-ctx.Done():
+		err = ctx.Err()
+	}
+
+	if c.HttpClient != nil {
+		c.HttpClient.CloseIdleConnections()
+	}
+	return err
+}
+
+// Close shuts the client down, waiting up to DefaultShutdownTimeout for
+// in-flight requests. It is safe to call more than once.
+func (c *Client) Close() error {
+	ctx, cancel := context.WithTimeout(context.Background(), DefaultShutdownTimeout)
+	defer cancel()
+
+	return c.Shutdown(ctx)
+}
+
+// closedError is returned for calls made after shutdown.
+func closedError() error {
+	return &GoNobitexError{
+		Message: "request not sent",
+		Err:     ErrClientClosed,
+	}
+}
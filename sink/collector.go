@@ -0,0 +1,122 @@
+package sink
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	nobitex "github.com/darhelm/go-nobitex"
+	t "github.com/darhelm/go-nobitex/types"
+)
+
+// DefaultCollectInterval is how often a Collector polls when no interval
+// is configured.
+const DefaultCollectInterval = time.Second
+
+// MarketSource provides market data. *nobitex.Client implements it.
+type MarketSource interface {
+	GetRecentTrades(symbol string, opts ...nobitex.RequestOption) (*t.Trades, error)
+	GetOrderBook(symbol string, opts ...nobitex.RequestOption) (*t.OrderBook, error)
+}
+
+// Collector polls trades and order books of Symbols and writes them to
+// Sink. Trades already written are skipped on later polls.
+//
+// Example:
+//
+//	c := &sink.Collector{Source: client, Sink: batcher, Symbols: []string{"BTCIRT", "USDTIRT"}}
+//	err := c.Run(ctx)
+type Collector struct {
+	Source  MarketSource
+	Sink    Sink
+	Symbols []string
+
+	// Interval is the polling period. Defaults to DefaultCollectInterval.
+	Interval time.Duration
+
+	// SkipTrades and SkipBooks disable one of the two feeds.
+	SkipTrades bool
+	SkipBooks  bool
+
+	// OnError, if set, receives fetch and sink failures; collection
+	// continues on the next tick.
+	OnError func(err error)
+
+	// lastTrade is the newest trade time written per symbol.
+	lastTrade map[string]int64
+}
+
+// Run collects until ctx is done, then returns ctx.Err().
+func (c *Collector) Run(ctx context.Context) error {
+	interval := c.Interval
+	if interval <= 0 {
+		interval = DefaultCollectInterval
+	}
+	if c.lastTrade == nil {
+		c.lastTrade = make(map[string]int64)
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		for _, symbol := range c.Symbols {
+			if !c.SkipTrades {
+				c.report(ctx, c.collectTrades(ctx, symbol))
+			}
+			if !c.SkipBooks {
+				c.report(ctx, c.collectBook(ctx, symbol))
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// collectTrades writes the trades of symbol newer than the last poll,
+// oldest first.
+func (c *Collector) collectTrades(ctx context.Context, symbol string) error {
+	trades, err := c.Source.GetRecentTrades(symbol, nobitex.WithContext(ctx))
+	if err != nil {
+		return fmt.Errorf("sink: fetching %s trades: %w", symbol, err)
+	}
+
+	last := c.lastTrade[symbol]
+	newest := last
+	// Recent trades are sorted newest-first
+	for i := len(trades.Trades) - 1; i >= 0; i-- {
+		trade := trades.Trades[i]
+		if trade.Time <= last {
+			continue
+		}
+		if err := c.Sink.WriteTrade(ctx, TradeRecord{Symbol: symbol, Trade: trade}); err != nil {
+			return fmt.Errorf("sink: writing %s trade: %w", symbol, err)
+		}
+		newest = max(newest, trade.Time)
+	}
+	c.lastTrade[symbol] = newest
+	return nil
+}
+
+// collectBook writes one order book snapshot of symbol.
+func (c *Collector) collectBook(ctx context.Context, symbol string) error {
+	book, err := c.Source.GetOrderBook(symbol, nobitex.WithContext(ctx))
+	if err != nil {
+		return fmt.Errorf("sink: fetching %s order book: %w", symbol, err)
+	}
+	if err := c.Sink.WriteBookUpdate(ctx, BookUpdate{Symbol: symbol, Book: *book, At: time.Now()}); err != nil {
+		return fmt.Errorf("sink: writing %s order book: %w", symbol, err)
+	}
+	return nil
+}
+
+// report passes err to OnError unless ctx is done.
+func (c *Collector) report(ctx context.Context, err error) {
+	if err != nil && c.OnError != nil && ctx.Err() == nil {
+		c.OnError(err)
+	}
+}
@@ -0,0 +1,212 @@
+// Package sink forwards streamed market data (trades, candles, order book
+// updates) to external systems such as Kafka, NATS or TimescaleDB.
+//
+// Implement Sink for record-at-a-time delivery, or BatchSink and wrap it
+// in a Batcher to write in bulk.
+package sink
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	t "github.com/darhelm/go-nobitex/types"
+)
+
+// Defaults used by a Batcher.
+const (
+	DefaultBatchSize     = 500
+	DefaultFlushInterval = time.Second
+)
+
+// TradeRecord is a public trade of one market.
+type TradeRecord struct {
+	Symbol string  `json:"symbol"`
+	Trade  t.Trade `json:"trade"`
+}
+
+// CandleRecord is one OHLCV bar of one market.
+type CandleRecord struct {
+	Symbol     string   `json:"symbol"`
+	Resolution string   `json:"resolution"`
+	Candle     t.Candle `json:"candle"`
+}
+
+// BookUpdate is an order book snapshot of one market.
+type BookUpdate struct {
+	Symbol string      `json:"symbol"`
+	Book   t.OrderBook `json:"book"`
+
+	// At is when the snapshot was received.
+	At time.Time `json:"at"`
+}
+
+// Sink receives market data records one at a time.
+type Sink interface {
+	WriteTrade(ctx context.Context, record TradeRecord) error
+	WriteCandle(ctx context.Context, record CandleRecord) error
+	WriteBookUpdate(ctx context.Context, update BookUpdate) error
+}
+
+// Batch groups records buffered by a Batcher.
+type Batch struct {
+	Trades  []TradeRecord
+	Candles []CandleRecord
+	Books   []BookUpdate
+}
+
+// Len returns the number of records in the batch.
+func (b Batch) Len() int {
+	return len(b.Trades) + len(b.Candles) + len(b.Books)
+}
+
+// BatchSink receives records in bulk.
+type BatchSink interface {
+	WriteBatch(ctx context.Context, batch Batch) error
+}
+
+// BatchSinkFunc adapts a plain function to the BatchSink interface.
+type BatchSinkFunc func(ctx context.Context, batch Batch) error
+
+// WriteBatch calls f(ctx, batch).
+func (f BatchSinkFunc) WriteBatch(ctx context.Context, batch Batch) error {
+	return f(ctx, batch)
+}
+
+// Batcher is a Sink that buffers records and hands them to Target once
+// Size records are pending or FlushInterval has passed, whichever comes
+// first. It is safe for concurrent use.
+//
+// Example:
+//
+//	b := sink.NewBatcher(sink.BatchSinkFunc(func(ctx context.Context, batch sink.Batch) error {
+//	    return producer.Publish(ctx, batch.Trades)
+//	}), 0, 0)
+//	go b.Run(ctx)
+//	defer b.Close(context.Background())
+type Batcher struct {
+	target        BatchSink
+	size          int
+	flushInterval time.Duration
+
+	// OnError, if set, receives failures of background flushes.
+	OnError func(err error)
+
+	mu      sync.Mutex
+	pending Batch
+}
+
+// NewBatcher returns a Batcher writing to target. A zero size or interval
+// uses DefaultBatchSize and DefaultFlushInterval.
+func NewBatcher(target BatchSink, size int, flushInterval time.Duration) *Batcher {
+	if size <= 0 {
+		size = DefaultBatchSize
+	}
+	if flushInterval <= 0 {
+		flushInterval = DefaultFlushInterval
+	}
+	return &Batcher{target: target, size: size, flushInterval: flushInterval}
+}
+
+// WriteTrade buffers a trade, flushing when the batch is full.
+func (b *Batcher) WriteTrade(ctx context.Context, record TradeRecord) error {
+	return b.add(ctx, func(batch *Batch) { batch.Trades = append(batch.Trades, record) })
+}
+
+// WriteCandle buffers a candle, flushing when the batch is full.
+func (b *Batcher) WriteCandle(ctx context.Context, record CandleRecord) error {
+	return b.add(ctx, func(batch *Batch) { batch.Candles = append(batch.Candles, record) })
+}
+
+// WriteBookUpdate buffers a book update, flushing when the batch is full.
+func (b *Batcher) WriteBookUpdate(ctx context.Context, update BookUpdate) error {
+	return b.add(ctx, func(batch *Batch) { batch.Books = append(batch.Books, update) })
+}
+
+// add appends a record and flushes a full batch in the caller's
+// goroutine, which applies backpressure to the producer.
+func (b *Batcher) add(ctx context.Context, appendTo func(*Batch)) error {
+	b.mu.Lock()
+	appendTo(&b.pending)
+	if b.pending.Len() < b.size {
+		b.mu.Unlock()
+		return nil
+	}
+	batch := b.take()
+	b.mu.Unlock()
+
+	return b.target.WriteBatch(ctx, batch)
+}
+
+// take detaches the pending batch. b.mu must be held.
+func (b *Batcher) take() Batch {
+	batch := b.pending
+	b.pending = Batch{}
+	return batch
+}
+
+// Flush writes any pending records now.
+func (b *Batcher) Flush(ctx context.Context) error {
+	b.mu.Lock()
+	batch := b.take()
+	b.mu.Unlock()
+
+	if batch.Len() == 0 {
+		return nil
+	}
+	return b.target.WriteBatch(ctx, batch)
+}
+
+// Run flushes pending records every FlushInterval until ctx is done, then
+// returns ctx.Err(). Records still pending at that point are left for
+// Close.
+func (b *Batcher) Run(ctx context.Context) error {
+	ticker := time.NewTicker(b.flushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+			if err := b.Flush(ctx); err != nil && b.OnError != nil {
+				b.OnError(err)
+			}
+		}
+	}
+}
+
+// Close flushes the remaining records.
+func (b *Batcher) Close(ctx context.Context) error {
+	return b.Flush(ctx)
+}
+
+// Multi fans every record out to several sinks, joining their errors.
+type Multi []Sink
+
+// WriteTrade writes record to every sink.
+func (m Multi) WriteTrade(ctx context.Context, record TradeRecord) error {
+	return m.each(func(s Sink) error { return s.WriteTrade(ctx, record) })
+}
+
+// WriteCandle writes record to every sink.
+func (m Multi) WriteCandle(ctx context.Context, record CandleRecord) error {
+	return m.each(func(s Sink) error { return s.WriteCandle(ctx, record) })
+}
+
+// WriteBookUpdate writes update to every sink.
+func (m Multi) WriteBookUpdate(ctx context.Context, update BookUpdate) error {
+	return m.each(func(s Sink) error { return s.WriteBookUpdate(ctx, update) })
+}
+
+// each applies write to every sink.
+func (m Multi) each(write func(Sink) error) error {
+	var errs []error
+	for _, s := range m {
+		if err := write(s); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
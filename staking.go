@@ -0,0 +1,138 @@
+package nobitex
+
+import (
+	t "github.com/darhelm/go-nobitex/types"
+)
+
+// GetStakingPlans lists the staking and yield plans offered by Nobitex.
+//
+// Endpoint:
+//
+//	GET /earn/plan/list
+//
+// Parameters:
+//   - params: t.GetStakingPlansParams
+//     Type ("staking", "yield_aggregator")
+//     Currency
+//
+// Returns:
+//   - *t.StakingPlans containing:
+//     Status
+//     Plans []StakingPlan
+//
+// Behavior:
+//   - Requires authentication.
+//
+// Example:
+//
+//	plans, _ := client.GetStakingPlans(t.GetStakingPlansParams{Type: "staking"})
+//	for _, p := range plans.Plans {
+//	    fmt.Println(p.Currency, p.EstimatedAPR, p.IsOpen)
+//	}
+func (c *Client) GetStakingPlans(params t.GetStakingPlansParams, opts ...RequestOption) (*t.StakingPlans, error) {
+	var plans *t.StakingPlans
+	err := c.ApiRequest("GET", "/earn/plan/list", "", true, false, params, &plans, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return plans, nil
+}
+
+// SubscribeStaking stakes an amount in a plan.
+//
+// Endpoint:
+//
+//	POST /earn/subscription/subscribe
+//
+// Parameters:
+//   - params: t.StakingSubscribeParams
+//     PlanId
+//     Amount
+//     AllowExtend (auto-renew)
+//
+// Returns:
+//   - *t.StakingSubscriptionResponse containing:
+//     Status
+//     Subscription → StakingSubscription
+//
+// Behavior:
+//   - Requires authentication.
+//   - The amount is debited from the spot wallet of the plan currency.
+//
+// Example:
+//
+//	sub, err := client.SubscribeStaking(t.StakingSubscribeParams{PlanId: 12, Amount: "1.5"})
+func (c *Client) SubscribeStaking(params t.StakingSubscribeParams, opts ...RequestOption) (*t.StakingSubscriptionResponse, error) {
+	var subscription *t.StakingSubscriptionResponse
+	err := c.ApiRequest("POST", "/earn/subscription/subscribe", "", true, false, params, &subscription, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return subscription, nil
+}
+
+// RedeemStaking requests the release of a staked amount.
+//
+// Endpoint:
+//
+//	POST /earn/subscription/redeem
+//
+// Parameters:
+//   - params: t.StakingRedeemParams
+//     PlanId
+//     Amount
+//
+// Returns:
+//   - *t.StakingSubscriptionResponse with the updated subscription.
+//
+// Behavior:
+//   - Requires authentication.
+//   - Funds are returned after the plan's UnstakingPeriod.
+//
+// Example:
+//
+//	sub, err := client.RedeemStaking(t.StakingRedeemParams{PlanId: 12, Amount: "1.5"})
+func (c *Client) RedeemStaking(params t.StakingRedeemParams, opts ...RequestOption) (*t.StakingSubscriptionResponse, error) {
+	var subscription *t.StakingSubscriptionResponse
+	err := c.ApiRequest("POST", "/earn/subscription/redeem", "", true, false, params, &subscription, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return subscription, nil
+}
+
+// GetStakingRewards retrieves one page of the rewards credited by staking
+// and yield plans.
+//
+// Endpoint:
+//
+//	GET /earn/reward/list
+//
+// Parameters:
+//   - params: t.GetStakingRewardsParams
+//     PlanId (optional plan filter)
+//     Page / PageSize
+//
+// Returns:
+//   - *t.StakingRewards containing:
+//     Status
+//     Rewards []StakingReward
+//     HasNext bool
+//
+// Behavior:
+//   - Requires authentication.
+//
+// Example:
+//
+//	rewards, _ := client.GetStakingRewards(t.GetStakingRewardsParams{Page: 1})
+//	for _, r := range rewards.Rewards {
+//	    fmt.Println(r.Currency, r.Amount, r.CreatedAt)
+//	}
+func (c *Client) GetStakingRewards(params t.GetStakingRewardsParams, opts ...RequestOption) (*t.StakingRewards, error) {
+	var rewards *t.StakingRewards
+	err := c.ApiRequest("GET", "/earn/reward/list", "", true, false, params, &rewards, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return rewards, nil
+}
@@ -0,0 +1,66 @@
+package nobitex
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+)
+
+// statusGetter is implemented by every response wrapper in package types.
+type statusGetter interface {
+	GetStatus() string
+}
+
+// CheckStatus returns an *APIError unless resp reports status "ok".
+// Nobitex occasionally answers HTTP 200 with {"status": "failed"}; this
+// centralizes the check callers would otherwise repeat. Every response
+// wrapper in package types implements GetStatus.
+//
+// Example:
+//
+//	wallets, err := client.GetWallets(params)
+//	if err == nil {
+//	    err = nobitex.CheckStatus(wallets)
+//	}
+//
+// ClientOptions.StrictStatus performs this check on every response.
+func CheckStatus(resp interface{ GetStatus() string }) error {
+	if v := reflect.ValueOf(resp); !v.IsValid() || v.Kind() == reflect.Pointer && v.IsNil() {
+		return &GoNobitexError{Message: "missing response"}
+	}
+
+	status := resp.GetStatus()
+	if status == "ok" {
+		return nil
+	}
+	message := fmt.Sprintf("unexpected response status %q", status)
+	return &APIError{
+		GoNobitexError: GoNobitexError{Message: message},
+		Status:         status,
+		Message:        message,
+		StatusCode:     http.StatusOK,
+	}
+}
+
+// checkResultStatus implements ClientOptions.StrictStatus for a decoded
+// result. Results without a status field pass; a failing status is
+// reported from the raw body so Code and Message are preserved.
+func checkResultStatus(result interface{}, body []byte) error {
+	v := reflect.ValueOf(result)
+	for v.IsValid() {
+		if v.Kind() == reflect.Pointer && v.IsNil() {
+			return nil
+		}
+		if getter, ok := v.Interface().(statusGetter); ok {
+			if getter.GetStatus() == "ok" {
+				return nil
+			}
+			return parseErrorResponse(http.StatusOK, body)
+		}
+		if v.Kind() != reflect.Pointer {
+			return nil
+		}
+		v = v.Elem()
+	}
+	return nil
+}
@@ -0,0 +1,129 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/url"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// FileStore keeps every bucket in a directory and every key in a file
+// below Dir. Writes go through a temporary file and a rename, so a crash
+// never leaves a half-written value behind.
+//
+// Example:
+//
+//	s, err := store.NewFileStore("/var/lib/bot/state")
+//	client := nobitex.NewClient(nobitex.ClientOptions{Store: s, ...})
+type FileStore struct {
+	dir string
+	mu  sync.RWMutex
+}
+
+// NewFileStore creates dir if needed and returns a FileStore rooted there.
+func NewFileStore(dir string) (*FileStore, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("store: %w", err)
+	}
+	return &FileStore{dir: dir}, nil
+}
+
+// Get returns the value of key, or ErrNotFound.
+func (f *FileStore) Get(_ context.Context, bucket, key string) ([]byte, error) {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+
+	value, err := os.ReadFile(f.path(bucket, key))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, ErrNotFound
+	}
+	return value, err
+}
+
+// Put atomically replaces the value of key.
+func (f *FileStore) Put(_ context.Context, bucket, key string, value []byte) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	dir := filepath.Join(f.dir, escapeKey(bucket))
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return fmt.Errorf("store: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(dir, ".tmp-*")
+	if err != nil {
+		return fmt.Errorf("store: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(value); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("store: %w", err)
+	}
+	if err := tmp.Sync(); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("store: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("store: %w", err)
+	}
+	return os.Rename(tmp.Name(), f.path(bucket, key))
+}
+
+// Delete removes key.
+func (f *FileStore) Delete(_ context.Context, bucket, key string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	err := os.Remove(f.path(bucket, key))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	return err
+}
+
+// Keys lists the keys of bucket in ascending order.
+func (f *FileStore) Keys(_ context.Context, bucket string) ([]string, error) {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+
+	entries, err := os.ReadDir(filepath.Join(f.dir, escapeKey(bucket)))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		if entry.IsDir() || strings.HasPrefix(entry.Name(), ".tmp-") {
+			continue
+		}
+		key, err := url.PathUnescape(entry.Name())
+		if err != nil {
+			continue
+		}
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	return keys, nil
+}
+
+// path maps a bucket and key to a file name. Escaping keeps keys such as
+// "dca/weekly" inside their bucket directory.
+func (f *FileStore) path(bucket, key string) string {
+	return filepath.Join(f.dir, escapeKey(bucket), escapeKey(key))
+}
+
+// escapeKey escapes key for use as a single file name. Dots are escaped
+// too, so keys cannot collide with "..", "." or temporary files.
+func escapeKey(key string) string {
+	return strings.ReplaceAll(url.PathEscape(key), ".", "%2E")
+}
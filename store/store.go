@@ -0,0 +1,123 @@
+// Package store persists state of the SDK's automation subsystems (order
+// tracking, backfill cursors, DCA journals) so a restarted process can
+// resume where it stopped.
+//
+// Store is a small bucketed key/value interface. MemoryStore and FileStore
+// ship with the package. Database-backed stores such as bbolt or SQLite
+// are left to separate adapter modules, so the SDK itself stays free of
+// database drivers; they only need to implement the four methods.
+package store
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"slices"
+	"sync"
+)
+
+// ErrNotFound is returned by Get when a key does not exist.
+var ErrNotFound = errors.New("store: key not found")
+
+// Buckets used by the SDK.
+const (
+	// BucketOrders holds orders tracked by the client's event bus, keyed
+	// by order id.
+	BucketOrders = "orders"
+
+	// BucketCursors holds resume cursors, keyed by job name.
+	BucketCursors = "cursors"
+
+	// BucketJournal holds DCA executions.
+	BucketJournal = "journal"
+)
+
+// Store is a bucketed key/value store. Implementations must be safe for
+// concurrent use.
+type Store interface {
+	// Get returns the value of key, or ErrNotFound.
+	Get(ctx context.Context, bucket, key string) ([]byte, error)
+
+	// Put stores value under key, replacing any previous value.
+	Put(ctx context.Context, bucket, key string, value []byte) error
+
+	// Delete removes key. Deleting a missing key is not an error.
+	Delete(ctx context.Context, bucket, key string) error
+
+	// Keys lists the keys of bucket in ascending order.
+	Keys(ctx context.Context, bucket string) ([]string, error)
+}
+
+// GetJSON decodes the value of key into v.
+func GetJSON(ctx context.Context, s Store, bucket, key string, v any) error {
+	value, err := s.Get(ctx, bucket, key)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(value, v)
+}
+
+// PutJSON stores v encoded as JSON under key.
+func PutJSON(ctx context.Context, s Store, bucket, key string, v any) error {
+	value, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	return s.Put(ctx, bucket, key, value)
+}
+
+// MemoryStore is an in-memory Store, mainly useful for tests and
+// short-lived processes. The zero value is ready to use.
+type MemoryStore struct {
+	mu      sync.Mutex
+	buckets map[string]map[string][]byte
+}
+
+// Get returns the value of key, or ErrNotFound.
+func (m *MemoryStore) Get(_ context.Context, bucket, key string) ([]byte, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	value, ok := m.buckets[bucket][key]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return slices.Clone(value), nil
+}
+
+// Put stores a copy of value under key.
+func (m *MemoryStore) Put(_ context.Context, bucket, key string, value []byte) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.buckets == nil {
+		m.buckets = make(map[string]map[string][]byte)
+	}
+	if m.buckets[bucket] == nil {
+		m.buckets[bucket] = make(map[string][]byte)
+	}
+	m.buckets[bucket][key] = slices.Clone(value)
+	return nil
+}
+
+// Delete removes key.
+func (m *MemoryStore) Delete(_ context.Context, bucket, key string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delete(m.buckets[bucket], key)
+	return nil
+}
+
+// Keys lists the keys of bucket in ascending order.
+func (m *MemoryStore) Keys(_ context.Context, bucket string) ([]string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	keys := make([]string, 0, len(m.buckets[bucket]))
+	for key := range m.buckets[bucket] {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	return keys, nil
+}
@@ -0,0 +1,90 @@
+package nobitex
+
+import (
+	"context"
+	"math/big"
+	"strings"
+
+	t "github.com/darhelm/go-nobitex/types"
+)
+
+// GetOpenOrdersSummary walks every open order matching params and
+// aggregates per-market counts, locked amounts and the oldest order, for
+// risk dashboards and pre-trade checks.
+//
+// Parameters:
+//   - ctx: Cancels the paginated listing.
+//   - params: Optional currency/side filters; Status and Order are set
+//     internally.
+//
+// Example:
+//
+//	summary, err := client.GetOpenOrdersSummary(ctx, t.GetOrdersListParams{})
+//	for market, m := range summary.Markets {
+//	    fmt.Println(market, m.Buys, m.Sells, m.LockedQuote)
+//	}
+func (c *Client) GetOpenOrdersSummary(ctx context.Context, params t.GetOrdersListParams) (*t.OpenOrdersSummary, error) {
+	params.Status = "open"
+	params.Order = t.SortIdAsc
+
+	type locked struct{ base, quote *big.Rat }
+	totals := make(map[string]locked)
+	summary := &t.OpenOrdersSummary{Markets: make(map[string]t.MarketOrdersSummary)}
+
+	for order, err := range c.Orders(ctx, params) {
+		if err != nil {
+			return nil, err
+		}
+
+		market := MarketSymbol(order.SrcCurrency, order.DstCurrency)
+		m := summary.Markets[market]
+		lock, ok := totals[market]
+		if !ok {
+			lock = locked{base: new(big.Rat), quote: new(big.Rat)}
+			totals[market] = lock
+		}
+
+		remaining := unmatchedAmount(order)
+		switch strings.ToLower(order.Type) {
+		case "buy":
+			m.Buys++
+			if price, ok := order.Price.Decimal(); ok {
+				lock.quote.Add(lock.quote, new(big.Rat).Mul(remaining, price))
+			}
+		case "sell":
+			m.Sells++
+			lock.base.Add(lock.base, remaining)
+		}
+		summary.Markets[market] = m
+		summary.Count++
+
+		if summary.Oldest == nil || order.CreatedAt.Before(summary.Oldest.CreatedAt) {
+			oldest := order
+			summary.Oldest = &oldest
+		}
+	}
+
+	for market, lock := range totals {
+		m := summary.Markets[market]
+		m.LockedBase = FormatDecimal(lock.base)
+		m.LockedQuote = FormatDecimal(lock.quote)
+		summary.Markets[market] = m
+	}
+	return summary, nil
+}
+
+// unmatchedAmount returns Amount - MatchedAmount of order, or zero when
+// either is malformed.
+func unmatchedAmount(order t.OrdersListResponse) *big.Rat {
+	amount, ok := new(big.Rat).SetString(order.Amount)
+	if !ok {
+		return new(big.Rat)
+	}
+	if matched, ok := new(big.Rat).SetString(order.MatchedAmount); ok {
+		amount.Sub(amount, matched)
+	}
+	if amount.Sign() < 0 {
+		amount.SetInt64(0)
+	}
+	return amount
+}
@@ -0,0 +1,57 @@
+package nobitex
+
+import (
+	"fmt"
+	"strings"
+)
+
+// symbolAliases maps quote suffixes to the spelling market data endpoints
+// expect; Nobitex names rial markets after the toman ("IRT") even though
+// their prices are in rials.
+var symbolAliases = map[string]string{
+	"RLS": "IRT",
+}
+
+// NormalizeSymbol validates a market symbol and returns it in the form
+// Nobitex endpoints expect: upper case, with the quote spelled "IRT" or
+// "USDT" ("btcrls" → "BTCIRT").
+//
+// Returns:
+//   - A GoNobitexError for symbols with separators or other characters,
+//     a missing base currency, or an unknown quote currency. Separated
+//     forms such as "btc-usdt" are rejected with the expected spelling in
+//     the message rather than sent to Nobitex, where they produce a
+//     confusing 404 page.
+//
+// Example:
+//
+//	symbol, err := nobitex.NormalizeSymbol("ethusdt") // "ETHUSDT"
+func NormalizeSymbol(symbol string) (string, error) {
+	normalized := strings.ToUpper(strings.TrimSpace(symbol))
+
+	for _, r := range normalized {
+		if (r < 'A' || r > 'Z') && (r < '0' || r > '9') {
+			hint := strings.Map(func(r rune) rune {
+				if (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') {
+					return r
+				}
+				return -1
+			}, normalized)
+			if canonical, err := NormalizeSymbol(hint); err == nil {
+				hint = canonical
+			}
+			return "", &GoNobitexError{Message: fmt.Sprintf("invalid market symbol %q: use the form %q", symbol, hint)}
+		}
+	}
+
+	for alias, canonical := range symbolAliases {
+		if base, ok := strings.CutSuffix(normalized, alias); ok {
+			normalized = base + canonical
+		}
+	}
+
+	if _, _, err := splitMarketSymbol(normalized); err != nil {
+		return "", &GoNobitexError{Message: fmt.Sprintf("invalid market symbol %q: expected a base currency followed by IRT or USDT", symbol)}
+	}
+	return normalized, nil
+}
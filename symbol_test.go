@@ -0,0 +1,73 @@
+package nobitex
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestNormalizeSymbol(t *testing.T) {
+	tests := []struct {
+		symbol string
+		want   string
+	}{
+		{"BTCIRT", "BTCIRT"},
+		{"btcirt", "BTCIRT"},
+		{"btcrls", "BTCIRT"},
+		{" ethusdt ", "ETHUSDT"},
+		{"1INCHUSDT", "1INCHUSDT"},
+	}
+	for _, tt := range tests {
+		got, err := NormalizeSymbol(tt.symbol)
+		if err != nil {
+			t.Errorf("NormalizeSymbol(%q): %v", tt.symbol, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("NormalizeSymbol(%q) = %q, want %q", tt.symbol, got, tt.want)
+		}
+	}
+}
+
+func TestNormalizeSymbolRejects(t *testing.T) {
+	tests := []struct {
+		symbol string
+		hint   string
+	}{
+		{"btc-usdt", `"BTCUSDT"`},
+		{"BTC/IRT", `"BTCIRT"`},
+		{"btc_rls", `"BTCIRT"`},
+		{"", "expected a base currency"},
+		{"IRT", "expected a base currency"},
+		{"BTCEUR", "expected a base currency"},
+	}
+	for _, tt := range tests {
+		_, err := NormalizeSymbol(tt.symbol)
+		if err == nil {
+			t.Errorf("NormalizeSymbol(%q) accepted an invalid symbol", tt.symbol)
+			continue
+		}
+		if !strings.Contains(err.Error(), tt.hint) {
+			t.Errorf("NormalizeSymbol(%q) error %q does not mention %s", tt.symbol, err, tt.hint)
+		}
+	}
+}
+
+func TestMarketDataRequestsUseNormalizedSymbol(t *testing.T) {
+	var paths []string
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		_, _ = w.Write([]byte(`{"status":"ok","trades":[]}`))
+	}), ClientOptions{})
+
+	if _, err := c.GetRecentTrades("btcrls"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.GetRecentTrades("btc-usdt"); err == nil {
+		t.Fatal("GetRecentTrades accepted a separated symbol")
+	}
+
+	if len(paths) != 1 || paths[0] != "/v2/trades/BTCIRT" {
+		t.Fatalf("requested %v, want only /v2/trades/BTCIRT", paths)
+	}
+}
@@ -0,0 +1,38 @@
+package nobitex
+
+import (
+	"io"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestTimeoutSparesStreamedBodies(t *testing.T) {
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/slow-headers" {
+			time.Sleep(200 * time.Millisecond)
+		}
+		w.WriteHeader(http.StatusOK)
+		w.(http.Flusher).Flush()
+		time.Sleep(200 * time.Millisecond)
+		_, _ = w.Write([]byte(`{"status":"ok"}`))
+	}), ClientOptions{Timeout: 50 * time.Millisecond})
+
+	err := c.ApiRequestStream("GET", "/stream", "", false, false, nil, func(r io.Reader) error {
+		_, err := io.ReadAll(r)
+		return err
+	})
+	if err != nil {
+		t.Fatalf("streamed body was cut off: %v", err)
+	}
+
+	var res map[string]any
+	if err := c.ApiRequest("GET", "/decoded", "", false, false, nil, &res); err == nil {
+		t.Fatal("decoded call outlived the client timeout")
+	}
+
+	err = c.ApiRequestStream("GET", "/slow-headers", "", false, false, nil, func(r io.Reader) error { return nil })
+	if err == nil {
+		t.Fatal("stream waited past the client timeout for headers")
+	}
+}
@@ -0,0 +1,75 @@
+package nobitex
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	t "github.com/darhelm/go-nobitex/types"
+)
+
+// maxSeekId bounds the exponential probe of seekTradeId.
+const maxSeekId = 1 << 40
+
+// seekTradeId finds a FromId close to the first trade executed at or
+// after from, so a time-bounded listing does not download the history
+// before it. /market/trades/list has no date filter, but trade ids grow
+// with time, which allows a binary search over FromId.
+//
+// It returns the largest probed id whose first trade is still before
+// from (0 when none is); trades before from on the first page are then
+// skipped by the caller's time filter.
+func (c *Client) seekTradeId(ctx context.Context, params t.GetUserTradesParams, from time.Time) (int, error) {
+	// probe reports the timestamp of the lowest-id trade at or after id
+	probe := func(id int) (time.Time, bool, error) {
+		params.FromId = strconv.Itoa(id)
+		params.Page = 0
+		page, err := c.userTradesPage(params, WithContext(ctx))
+		if err != nil {
+			return time.Time{}, false, err
+		}
+		if len(page.Trades) == 0 {
+			return time.Time{}, false, nil
+		}
+
+		first := page.Trades[0]
+		for _, trade := range page.Trades[1:] {
+			if trade.Id < first.Id {
+				first = trade
+			}
+		}
+		return first.Timestamp, true, nil
+	}
+
+	before := func(id int) (bool, error) {
+		at, ok, err := probe(id)
+		return ok && at.Before(from), err
+	}
+
+	// Grow hi until its first trade is at/after from, or no trades remain
+	lo, hi := 0, 1
+	for hi < maxSeekId {
+		ok, err := before(hi)
+		if err != nil {
+			return 0, err
+		}
+		if !ok {
+			break
+		}
+		lo, hi = hi, hi*2
+	}
+
+	for hi-lo > 1 {
+		mid := lo + (hi-lo)/2
+		ok, err := before(mid)
+		if err != nil {
+			return 0, err
+		}
+		if ok {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+	return lo, nil
+}
@@ -1,5 +1,10 @@
 package types
 
+import (
+	"fmt"
+	"strings"
+)
+
 // AuthenticationParams defines the request payload and required headers
 // for initiating a Nobitex authentication session.
 type AuthenticationParams struct {
@@ -10,7 +15,7 @@ type AuthenticationParams struct {
 	Password string `json:"password"`
 
 	// Remember determines whether the server returns a long-lived token.
-	Remember string `json:"remember"`
+	Remember Remember `json:"remember"`
 
 	// Captcha must be set to "api" for bot authentication flows.
 	Captcha string `json:"captcha"`
@@ -37,3 +42,45 @@ type AuthenticationResponse struct {
 	// Device represents a unique ID assigned to the authenticated session.
 	Device string `json:"device"`
 }
+
+// Remember selects the lifetime of the API key issued by /auth/login/.
+type Remember string
+
+const (
+	// RememberNo requests a short-lived key (about 4 hours). It is the
+	// default when Remember is empty.
+	RememberNo Remember = "no"
+
+	// RememberYes requests a long-lived key (about 30 days).
+	RememberYes Remember = "yes"
+)
+
+// ParseRemember converts a configuration value into a Remember. Besides
+// "yes" and "no" it accepts the boolean spellings "true"/"false",
+// "1"/"0" and "on"/"off", case-insensitively; empty means RememberNo.
+func ParseRemember(value string) (Remember, error) {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "", "no", "false", "0", "off":
+		return RememberNo, nil
+	case "yes", "true", "1", "on":
+		return RememberYes, nil
+	default:
+		return "", fmt.Errorf("invalid Remember value %q (want \"yes\" or \"no\")", value)
+	}
+}
+
+// Valid reports whether r is RememberYes, RememberNo or empty.
+func (r Remember) Valid() bool {
+	return r == "" || r == RememberNo || r == RememberYes
+}
+
+// UnmarshalText parses r with ParseRemember, so Remember fields accept the
+// same spellings in JSON, YAML or environment-driven configuration.
+func (r *Remember) UnmarshalText(text []byte) error {
+	parsed, err := ParseRemember(string(text))
+	if err != nil {
+		return err
+	}
+	*r = parsed
+	return nil
+}
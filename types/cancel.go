@@ -40,5 +40,6 @@ type CancelOrderBulkParams struct {
 
 // CancelOrderResponse is always {"status": "ok"} if nothing other than status code 200 is returned
 type CancelOrderResponse struct {
+	// Status indicates the result of the request.
 	Status string `json:"status"`
 }
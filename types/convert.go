@@ -0,0 +1,102 @@
+package types
+
+import "time"
+
+// GetConvertQuoteParams requests a fixed-rate quote for an instant
+// conversion (Xchange) between two currencies.
+type GetConvertQuoteParams struct {
+	// SrcCurrency is the currency being sold, e.g. "usdt".
+	SrcCurrency string `json:"srcCurrency"`
+
+	// DstCurrency is the currency being bought, e.g. "btc".
+	DstCurrency string `json:"dstCurrency"`
+
+	// Amount is the quantity to convert. It is expressed in SrcCurrency
+	// when IsSell is true and in DstCurrency otherwise.
+	Amount string `json:"amount"`
+
+	// IsSell selects whether Amount is the sold (true) or bought (false)
+	// side of the conversion.
+	IsSell bool `json:"isSell"`
+}
+
+// ConvertQuote is a fixed-rate offer that can be executed until it expires.
+type ConvertQuote struct {
+	// QuoteId identifies the quote when calling ExecuteConvert.
+	QuoteId string `json:"quoteId"`
+
+	// SrcCurrency is the currency being sold.
+	SrcCurrency string `json:"srcCurrency"`
+
+	// DstCurrency is the currency being bought.
+	DstCurrency string `json:"dstCurrency"`
+
+	// SrcAmount is the quantity of SrcCurrency that will be debited.
+	SrcAmount string `json:"srcAmount"`
+
+	// DstAmount is the quantity of DstCurrency that will be credited.
+	DstAmount string `json:"dstAmount"`
+
+	// Rate is the fixed conversion price in DstCurrency per SrcCurrency.
+	Rate string `json:"rate"`
+
+	// Fee is the fee included in the quote.
+	Fee string `json:"fee,omitempty"`
+
+	// ExpiresAt is the last moment the quote can be executed.
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+// ConvertQuoteResponse wraps a quote together with a status field.
+type ConvertQuoteResponse struct {
+	// Status indicates the result of the request.
+	Status string `json:"status"`
+
+	// Quote is the offered conversion.
+	Quote ConvertQuote `json:"quote"`
+}
+
+// ExecuteConvertParams executes a previously obtained quote.
+type ExecuteConvertParams struct {
+	// QuoteId is ConvertQuote.QuoteId of an unexpired quote.
+	QuoteId string `json:"quoteId"`
+}
+
+// ConvertTrade is a settled instant conversion.
+type ConvertTrade struct {
+	// Id is the unique identifier of the conversion.
+	Id int `json:"id"`
+
+	// QuoteId is the quote the conversion was executed from.
+	QuoteId string `json:"quoteId"`
+
+	// SrcCurrency is the currency that was sold.
+	SrcCurrency string `json:"srcCurrency"`
+
+	// DstCurrency is the currency that was bought.
+	DstCurrency string `json:"dstCurrency"`
+
+	// SrcAmount is the debited quantity of SrcCurrency.
+	SrcAmount string `json:"srcAmount"`
+
+	// DstAmount is the credited quantity of DstCurrency.
+	DstAmount string `json:"dstAmount"`
+
+	// Rate is the executed conversion price.
+	Rate string `json:"rate"`
+
+	// Status is the settlement state, e.g. "done".
+	Status string `json:"status"`
+
+	// CreatedAt is when the conversion was executed.
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// ConvertTradeResponse wraps an executed conversion together with a status field.
+type ConvertTradeResponse struct {
+	// Status indicates the result of the request.
+	Status string `json:"status"`
+
+	// Trade is the executed conversion.
+	Trade ConvertTrade `json:"trade"`
+}
@@ -0,0 +1,42 @@
+package types
+
+// TradeCost breaks down the money flow of an order, with every value
+// formatted as a decimal string exactly like Nobitex reports it.
+type TradeCost struct {
+	// Market is the market symbol, e.g. "BTCIRT".
+	Market string `json:"market"`
+
+	// Type is the order side, "buy" or "sell".
+	Type string `json:"type"`
+
+	// Amount is the base-currency amount after rounding to the market's
+	// amount precision.
+	Amount string `json:"amount"`
+
+	// Price is the price after rounding to the market's price precision.
+	Price string `json:"price"`
+
+	// Gross is Amount × Price in the quote currency.
+	Gross string `json:"gross"`
+
+	// FeeRate is the applied fee, in percent.
+	FeeRate string `json:"feeRate"`
+
+	// Fee is the charged fee, expressed in FeeCurrency.
+	Fee string `json:"fee"`
+
+	// FeeCurrency is the currency the fee is charged in: the base currency
+	// for buys and the quote currency for sells.
+	FeeCurrency string `json:"feeCurrency"`
+
+	// Net is what the order yields after fees: the base amount received
+	// for buys, the quote amount received for sells.
+	Net string `json:"net"`
+
+	// Total is the quote amount spent for buys or received for sells.
+	Total string `json:"total"`
+
+	// BreakEvenPrice is the price at which reversing the trade (paying the
+	// same fee rate again) returns exactly the starting balance.
+	BreakEvenPrice string `json:"breakEvenPrice"`
+}
@@ -0,0 +1,88 @@
+package types
+
+import "strings"
+
+// CurrencyInfo is the deposit and withdrawal metadata of one currency, as
+// published in the "coins" list of /v2/options.
+type CurrencyInfo struct {
+	// Coin is the lower-case currency symbol, e.g. "usdt".
+	Coin string `json:"coin"`
+
+	// Name is the display name of the currency, e.g. "Tether".
+	Name string `json:"name"`
+
+	// Networks maps a network name (e.g. "TRX", "ETH", "BSC") to its
+	// transfer settings.
+	Networks map[string]NetworkInfo `json:"networkList"`
+}
+
+// NetworkInfo is the transfer settings of a currency on one network.
+type NetworkInfo struct {
+	// Network is the network name Nobitex expects in transfer requests.
+	Network Network `json:"network"`
+
+	// Name is the display name of the network, e.g. "Tron (TRC20)".
+	Name string `json:"name"`
+
+	// IsDefault marks the network used when none is given.
+	IsDefault bool `json:"isDefault"`
+
+	// DepositEnable and WithdrawEnable report whether transfers in each
+	// direction are currently open.
+	DepositEnable  bool `json:"depositEnable"`
+	WithdrawEnable bool `json:"withdrawEnable"`
+
+	// WithdrawFee is the flat network fee deducted from withdrawals, in
+	// units of the currency.
+	WithdrawFee string `json:"withdrawFee"`
+
+	// WithdrawMin and WithdrawMax bound the amount of a single withdrawal.
+	WithdrawMin string `json:"withdrawMin"`
+	WithdrawMax string `json:"withdrawMax"`
+
+	// MinConfirm is the number of confirmations a deposit needs before it
+	// is credited.
+	MinConfirm int `json:"minConfirm"`
+
+	// AddressRegex validates destination addresses.
+	AddressRegex string `json:"addressRegex,omitempty"`
+
+	// MemoRequired reports whether withdrawals need a memo/tag, and
+	// MemoRegex validates it.
+	MemoRequired bool   `json:"memoRequired,omitempty"`
+	MemoRegex    string `json:"memoRegex,omitempty"`
+}
+
+// Network returns the settings of network, matched case-insensitively
+// against both the map key and NetworkInfo.Network. Token-standard
+// spellings such as "TRC20" are resolved with ParseNetwork.
+func (c CurrencyInfo) Network(network Network) (NetworkInfo, bool) {
+	if info, ok := c.Networks[string(network)]; ok {
+		return info, true
+	}
+	if parsed, err := ParseNetwork(string(network)); err == nil {
+		network = parsed
+	}
+	for key, info := range c.Networks {
+		if strings.EqualFold(key, string(network)) || strings.EqualFold(string(info.Network), string(network)) {
+			return info, true
+		}
+	}
+	return NetworkInfo{}, false
+}
+
+// DefaultNetwork returns the network marked IsDefault, or the only
+// network when exactly one is listed.
+func (c CurrencyInfo) DefaultNetwork() (NetworkInfo, bool) {
+	for _, info := range c.Networks {
+		if info.IsDefault {
+			return info, true
+		}
+	}
+	if len(c.Networks) == 1 {
+		for _, info := range c.Networks {
+			return info, true
+		}
+	}
+	return NetworkInfo{}, false
+}
@@ -0,0 +1,66 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// Decimal is a numeric value kept as its decimal string, like the Amount
+// and Fee fields. It decodes from a JSON string or number, since Nobitex
+// sends large rial values either way, and never loses precision.
+type Decimal string
+
+// Rat parses d as an exact decimal. It reports false for empty or
+// malformed values.
+func (d Decimal) Rat() (*big.Rat, bool) {
+	if d == "" {
+		return nil, false
+	}
+	return new(big.Rat).SetString(strings.TrimSpace(string(d)))
+}
+
+// Float64 parses d as a float64; empty values are 0.
+func (d Decimal) Float64() (float64, error) {
+	if d == "" {
+		return 0, nil
+	}
+	return strconv.ParseFloat(strings.TrimSpace(string(d)), 64)
+}
+
+// String returns the value as sent by Nobitex.
+func (d Decimal) String() string {
+	return string(d)
+}
+
+// UnmarshalJSON accepts the value as a JSON string or number.
+func (d *Decimal) UnmarshalJSON(data []byte) error {
+	s, err := decodeNumeric(data)
+	if err != nil {
+		return err
+	}
+	*d = Decimal(s)
+	return nil
+}
+
+// decodeNumeric returns the text of a JSON string or number; null is
+// empty.
+func decodeNumeric(data []byte) (string, error) {
+	data = bytes.TrimSpace(data)
+	if bytes.Equal(data, []byte("null")) {
+		return "", nil
+	}
+	if len(data) > 0 && data[0] == '"' {
+		var s string
+		err := json.Unmarshal(data, &s)
+		return s, err
+	}
+
+	var n json.Number
+	if err := json.Unmarshal(data, &n); err != nil {
+		return "", err
+	}
+	return n.String(), nil
+}
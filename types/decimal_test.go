@@ -0,0 +1,53 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestDecimal(t *testing.T) {
+	tests := []struct {
+		input string
+		want  Decimal
+		rat   string
+	}{
+		{`"123456789012345678901234.5"`, "123456789012345678901234.5", "246913578024691357802469/2"},
+		{`123456789012345678901234.5`, "123456789012345678901234.5", "246913578024691357802469/2"},
+		{`"0.10"`, "0.10", "1/10"},
+		{`1e3`, "1e3", "1000"},
+		{`null`, "", ""},
+		{`""`, "", ""},
+	}
+	for _, tt := range tests {
+		var d Decimal
+		if err := json.Unmarshal([]byte(tt.input), &d); err != nil {
+			t.Errorf("Unmarshal(%s): %v", tt.input, err)
+			continue
+		}
+		if d != tt.want {
+			t.Errorf("Unmarshal(%s) = %q, want %q", tt.input, d, tt.want)
+		}
+
+		r, ok := d.Rat()
+		switch {
+		case tt.rat == "" && ok:
+			t.Errorf("Decimal(%q).Rat() = %s, want none", d, r.RatString())
+		case tt.rat != "" && (!ok || r.RatString() != tt.rat):
+			t.Errorf("Decimal(%q).Rat() = %v, %v, want %s", d, r, ok, tt.rat)
+		}
+	}
+
+	for _, input := range []string{`true`, `{}`, `"1`} {
+		var d Decimal
+		if err := json.Unmarshal([]byte(input), &d); err == nil {
+			t.Errorf("Unmarshal(%s) decoded without error", input)
+		}
+	}
+
+	if f, err := Decimal("").Float64(); err != nil || f != 0 {
+		t.Errorf(`Decimal("").Float64() = %v, %v, want 0`, f, err)
+	}
+	if _, ok := Decimal("abc").Rat(); ok {
+		t.Error(`Decimal("abc").Rat() parsed a malformed value`)
+	}
+}
@@ -0,0 +1,44 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// FlexBool is a boolean that decodes from the several encodings Nobitex
+// uses for the same field: JSON booleans, the strings "true"/"false"
+// (any case), "1"/"0", "yes"/"no" and "", the numbers 1 and 0, and null.
+// It always encodes as a JSON boolean.
+type FlexBool bool
+
+// UnmarshalJSON implements the tolerant decoding described on FlexBool.
+func (b *FlexBool) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+
+	var value string
+	switch {
+	case len(data) > 0 && data[0] == '"':
+		if err := json.Unmarshal(data, &value); err != nil {
+			return err
+		}
+	default:
+		value = string(data)
+	}
+
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "true", "yes", "1":
+		*b = true
+	case "false", "no", "0", "", "null":
+		*b = false
+	default:
+		if n, err := strconv.ParseFloat(value, 64); err == nil {
+			*b = n != 0
+			return nil
+		}
+		return fmt.Errorf("cannot decode %s as a boolean", data)
+	}
+	return nil
+}
@@ -0,0 +1,54 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestFlexBool(t *testing.T) {
+	tests := []struct {
+		input string
+		want  FlexBool
+	}{
+		{`true`, true},
+		{`false`, false},
+		{`"true"`, true},
+		{`"False"`, false},
+		{`"yes"`, true},
+		{`"no"`, false},
+		{`"1"`, true},
+		{`"0"`, false},
+		{`""`, false},
+		{`1`, true},
+		{`0`, false},
+		{`2`, true},
+		{`null`, false},
+	}
+	for _, tt := range tests {
+		got := FlexBool(!tt.want)
+		if err := json.Unmarshal([]byte(tt.input), &got); err != nil {
+			t.Errorf("Unmarshal(%s): %v", tt.input, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("Unmarshal(%s) = %v, want %v", tt.input, got, tt.want)
+		}
+	}
+
+	for _, input := range []string{`"maybe"`, `[]`} {
+		var b FlexBool
+		if err := json.Unmarshal([]byte(input), &b); err == nil {
+			t.Errorf("Unmarshal(%s) decoded without error", input)
+		}
+	}
+
+	encoded, err := json.Marshal(struct {
+		Partial FlexBool `json:"partial"`
+	}{true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(encoded) != `{"partial":true}` {
+		t.Errorf("Marshal = %s, want a JSON boolean", encoded)
+	}
+}
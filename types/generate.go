@@ -0,0 +1,3 @@
+package types
+
+//go:generate go run ../internal/genstatus
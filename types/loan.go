@@ -0,0 +1,158 @@
+package types
+
+import "time"
+
+// GetLoanPlansParams filters the listing of crypto loan plans.
+type GetLoanPlansParams struct {
+	// Currency restricts the listing to plans lending one asset. Optional.
+	Currency string `json:"currency,omitempty"`
+}
+
+// LoanPlan describes a crypto-collateralized loan product.
+type LoanPlan struct {
+	// Id is the unique identifier of the plan.
+	Id int `json:"id"`
+
+	// Currency is the borrowed asset.
+	Currency string `json:"currency"`
+
+	// CollateralCurrencies lists the assets accepted as collateral.
+	CollateralCurrencies []string `json:"collateralCurrencies"`
+
+	// InterestRate is the interest charged over the loan period.
+	InterestRate string `json:"interestRate"`
+
+	// Period is the loan term in days.
+	Period int `json:"period"`
+
+	// MinAmount is the smallest loan the plan grants.
+	MinAmount string `json:"minAmount"`
+
+	// MaxAmount is the largest loan the plan grants.
+	MaxAmount string `json:"maxAmount"`
+
+	// InitialLTV is the maximum loan-to-value ratio when opening a loan.
+	InitialLTV string `json:"initialLTV"`
+
+	// MarginCallLTV is the ratio at which the user is asked to add collateral.
+	MarginCallLTV string `json:"marginCallLTV"`
+
+	// LiquidationLTV is the ratio at which the collateral is liquidated.
+	LiquidationLTV string `json:"liquidationLTV"`
+
+	// IsActive reports whether the plan accepts new loans.
+	IsActive bool `json:"isActive"`
+}
+
+// LoanPlans is the list of available loan plans.
+type LoanPlans struct {
+	// Status indicates the result of the request.
+	Status string `json:"status"`
+
+	// Plans lists the available plans.
+	Plans []LoanPlan `json:"plans"`
+}
+
+// OpenLoanParams opens a new loan against collateral.
+type OpenLoanParams struct {
+	// PlanId is LoanPlan.Id of the chosen plan.
+	PlanId int `json:"planId"`
+
+	// Amount is the quantity of the plan currency to borrow.
+	Amount string `json:"amount"`
+
+	// CollateralCurrency is the asset locked as collateral.
+	CollateralCurrency string `json:"collateralCurrency"`
+
+	// CollateralAmount is the quantity of collateral to lock.
+	CollateralAmount string `json:"collateralAmount"`
+}
+
+// RepayLoanParams repays part or all of an open loan.
+type RepayLoanParams struct {
+	// LoanId is Loan.Id of the loan being repaid.
+	LoanId int `json:"loanId"`
+
+	// Amount is the quantity to repay. Empty repays the full debt.
+	Amount string `json:"amount,omitempty"`
+}
+
+// GetLoansParams filters the listing of the user's loans.
+type GetLoansParams struct {
+	// Status restricts the listing to "open", "repaid" or "liquidated".
+	// Empty lists every loan.
+	Status string `json:"status,omitempty"`
+
+	// Page selects the 1-based result page. Defaults to the first page.
+	Page int `json:"page,omitempty"`
+
+	// PageSize limits the number of loans per page.
+	PageSize int `json:"pageSize,omitempty"`
+}
+
+// Loan is a user's loan together with its collateral health.
+type Loan struct {
+	// Id is the unique identifier of the loan.
+	Id int `json:"id"`
+
+	// PlanId identifies the plan the loan was opened under.
+	PlanId int `json:"planId"`
+
+	// Currency is the borrowed asset.
+	Currency string `json:"currency"`
+
+	// Amount is the borrowed principal.
+	Amount string `json:"amount"`
+
+	// Debt is the outstanding principal plus accrued interest.
+	Debt string `json:"debt"`
+
+	// CollateralCurrency is the locked collateral asset.
+	CollateralCurrency string `json:"collateralCurrency"`
+
+	// CollateralAmount is the quantity of locked collateral.
+	CollateralAmount string `json:"collateralAmount"`
+
+	// CurrentLTV is the present loan-to-value ratio of the loan.
+	CurrentLTV string `json:"currentLTV"`
+
+	// MarginCallLTV is the ratio at which more collateral is requested.
+	MarginCallLTV string `json:"marginCallLTV"`
+
+	// LiquidationLTV is the ratio at which the collateral is liquidated.
+	LiquidationLTV string `json:"liquidationLTV"`
+
+	// LiquidationPrice is the collateral price (in the loan currency) at
+	// which CurrentLTV reaches LiquidationLTV.
+	LiquidationPrice string `json:"liquidationPrice,omitempty"`
+
+	// Status is the lifecycle state, e.g. "open", "repaid" or "liquidated".
+	Status string `json:"status"`
+
+	// CreatedAt is when the loan was opened.
+	CreatedAt time.Time `json:"createdAt"`
+
+	// DueAt is when the loan term ends.
+	DueAt time.Time `json:"dueAt"`
+}
+
+// LoanResponse wraps a single loan together with a status field.
+type LoanResponse struct {
+	// Status indicates the result of the request.
+	Status string `json:"status"`
+
+	// Loan is the affected loan.
+	Loan Loan `json:"loan"`
+}
+
+// Loans is a page of loan records.
+type Loans struct {
+	// Status indicates the result of the request.
+	Status string `json:"status"`
+
+	// Loans lists the loans of the page.
+	Loans []Loan `json:"loans"`
+
+	// HasNext reports whether another page is available.
+	HasNext bool `json:"hasNext"`
+}
@@ -1,5 +1,11 @@
 package types
 
+import (
+	"bytes"
+	"encoding/json"
+	"time"
+)
+
 // Nobitex holds general market-wide configuration values,
 // including supported currencies and precision settings.
 type Nobitex struct {
@@ -14,6 +20,35 @@ type Nobitex struct {
 
 	// PricePrecisions specifies fractional precision limits for prices per currency.
 	PricePrecisions map[string]string `json:"pricePrecisions"`
+
+	// AmountSteps and PriceSteps are AmountPrecisions and PricePrecisions
+	// parsed when the payload is decoded. Prefer StepSize and TickSize.
+	AmountSteps map[string]Precision `json:"-"`
+	PriceSteps  map[string]Precision `json:"-"`
+
+	// ActiveMarkets lists the market symbols currently open for trading,
+	// e.g. "BTCIRT" or "ETHUSDT".
+	ActiveMarkets []string `json:"activeMarkets,omitempty"`
+
+	// EnabledFeatures lists the product features currently switched on,
+	// e.g. "convert", "staking" or "margin".
+	EnabledFeatures []string `json:"enabledFeatures,omitempty"`
+
+	// MinOrders maps a quote currency to the minimum order value accepted
+	// in markets quoted in it, e.g. "rls" → "3000000".
+	MinOrders map[string]string `json:"minOrders,omitempty"`
+
+	// TradingFees holds the default maker/taker fee rates per quote currency.
+	TradingFees map[string]TradingFee `json:"tradingFees,omitempty"`
+}
+
+// TradingFee is the fee schedule of markets quoted in one currency.
+type TradingFee struct {
+	// Maker is the fee rate, in percent, charged on maker fills.
+	Maker string `json:"maker"`
+
+	// Taker is the fee rate, in percent, charged on taker fills.
+	Taker string `json:"taker"`
 }
 
 // Ticker represents real-time market data for a trading pair,
@@ -72,7 +107,8 @@ type OrderBook struct {
 	// Status indicates the response state for the order book.
 	Status string `json:"status"`
 
-	// LastUpdate is a Unix timestamp of the last book update.
+	// LastUpdate is the time of the last book update in Unix
+	// milliseconds. Use LastUpdateTime or Age instead of converting it.
 	LastUpdate int64 `json:"lastUpdate"`
 
 	// LastTradePrice is the price of the most recent trade.
@@ -87,6 +123,25 @@ type OrderBook struct {
 	Bids [][]string `json:"bids"`
 }
 
+// LastUpdateTime returns LastUpdate as a time.Time; zero when the book
+// carries no timestamp.
+func (o *OrderBook) LastUpdateTime() time.Time {
+	if o.LastUpdate <= 0 {
+		return time.Time{}
+	}
+	return time.UnixMilli(o.LastUpdate)
+}
+
+// Age returns how long ago the book was last updated. A book without a
+// timestamp reports the maximum duration, so staleness checks such as
+// book.Age() > 5*time.Second treat it as stale.
+func (o *OrderBook) Age() time.Duration {
+	if o.LastUpdate <= 0 {
+		return time.Duration(1<<63 - 1)
+	}
+	return time.Since(o.LastUpdateTime())
+}
+
 // Trade represents a single executed trade, including
 // timestamp, price, size, and direction.
 type Trade struct {
@@ -106,6 +161,14 @@ type Trade struct {
 // Config wraps high-level platform configuration under a Nobitex key.
 type Config struct {
 	Nobitex Nobitex `json:"nobitex"`
+
+	// Features maps feature flags to whether they are enabled. Nobitex
+	// ships them next to the "nobitex" key.
+	Features map[string]bool `json:"features,omitempty"`
+
+	// Coins lists the deposit/withdrawal networks, fees and limits of
+	// every currency.
+	Coins []CurrencyInfo `json:"coins,omitempty"`
 }
 
 // Tickers represents multiple ticker entries,
@@ -126,3 +189,26 @@ type Trades struct {
 	// Trades is the list of individual trade records.
 	Trades []Trade `json:"trades"`
 }
+
+// UnmarshalJSON accepts both shapes the trades endpoint has served: the
+// {"status": ..., "trades": [...]} envelope and a bare array of trades.
+// A bare array carries no status and is reported as "ok".
+func (tr *Trades) UnmarshalJSON(data []byte) error {
+	if trimmed := bytes.TrimSpace(data); len(trimmed) > 0 && trimmed[0] == '[' {
+		var trades []Trade
+		if err := json.Unmarshal(trimmed, &trades); err != nil {
+			return err
+		}
+		*tr = Trades{Status: "ok", Trades: trades}
+		return nil
+	}
+
+	// The alias drops this method so the envelope decodes normally
+	type envelope Trades
+	var decoded envelope
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+	*tr = Trades(decoded)
+	return nil
+}
@@ -0,0 +1,35 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestTradesUnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  string
+		status string
+		count  int
+	}{
+		{"envelope", `{"status":"ok","trades":[{"time":1700000000000,"price":"100","volume":"0.5","type":"buy"}]}`, "ok", 1},
+		{"failed envelope", `{"status":"failed","trades":[]}`, "failed", 0},
+		{"bare array", ` [{"time":1700000000000,"price":"100","volume":"0.5","type":"sell"},{"time":1700000001000,"price":"101","volume":"1","type":"buy"}]`, "ok", 2},
+		{"empty array", `[]`, "ok", 0},
+	}
+	for _, tt := range tests {
+		var trades Trades
+		if err := json.Unmarshal([]byte(tt.input), &trades); err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+			continue
+		}
+		if trades.Status != tt.status || len(trades.Trades) != tt.count {
+			t.Errorf("%s: got status %q with %d trades, want %q with %d", tt.name, trades.Status, len(trades.Trades), tt.status, tt.count)
+		}
+	}
+
+	var trades Trades
+	if err := json.Unmarshal([]byte(`[{"time":"soon"}]`), &trades); err == nil {
+		t.Error("malformed trade decoded without error")
+	}
+}
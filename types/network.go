@@ -0,0 +1,67 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Network is a blockchain network as Nobitex names it in deposit and
+// withdrawal records and currency metadata, e.g. "TRX" for Tron (TRC20)
+// tokens.
+type Network string
+
+// Networks commonly used on Nobitex. The metadata returned by
+// Client.Currency is authoritative; other names are accepted as-is.
+const (
+	NetworkBTC     Network = "BTC"
+	NetworkETH     Network = "ETH"     // Ethereum, ERC20 tokens
+	NetworkTRX     Network = "TRX"     // Tron, TRC20 tokens
+	NetworkBSC     Network = "BSC"     // BNB Smart Chain, BEP20 tokens
+	NetworkBNB     Network = "BNB"     // BNB Beacon Chain, BEP2 tokens
+	NetworkPolygon Network = "POLYGON" // Polygon PoS
+	NetworkSOL     Network = "SOL"
+	NetworkTON     Network = "TON"
+	NetworkLTC     Network = "LTC"
+	NetworkDOGE    Network = "DOGE"
+	NetworkXRP     Network = "XRP"
+)
+
+// networkAliases maps token-standard spellings to Nobitex network names.
+var networkAliases = map[string]Network{
+	"ERC20": NetworkETH,
+	"TRC20": NetworkTRX,
+	"BEP20": NetworkBSC,
+	"BEP2":  NetworkBNB,
+	"MATIC": NetworkPolygon,
+}
+
+// ParseNetwork normalizes a network name: it is upper-cased and the
+// token-standard spellings "ERC20", "TRC20", "BEP20", "BEP2" and "MATIC"
+// are mapped to NetworkETH, NetworkTRX, NetworkBSC, NetworkBNB and
+// NetworkPolygon. Unknown names are returned upper-cased, since Nobitex
+// adds networks over time.
+func ParseNetwork(name string) (Network, error) {
+	name = strings.ToUpper(strings.TrimSpace(name))
+	if name == "" {
+		return "", fmt.Errorf("empty network name")
+	}
+	if network, ok := networkAliases[strings.ReplaceAll(name, "-", "")]; ok {
+		return network, nil
+	}
+	return Network(name), nil
+}
+
+// UnmarshalText parses n with ParseNetwork, so configuration may use
+// either "TRC20" or "TRX".
+func (n *Network) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*n = ""
+		return nil
+	}
+	parsed, err := ParseNetwork(string(text))
+	if err != nil {
+		return err
+	}
+	*n = parsed
+	return nil
+}
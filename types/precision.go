@@ -0,0 +1,92 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// Precision is a parsed entry of Nobitex.AmountPrecisions or
+// Nobitex.PricePrecisions.
+type Precision struct {
+	// Value is the step as published, e.g. "0.000001" or "10".
+	Value string
+
+	// Step is Value as an exact decimal.
+	Step *big.Rat
+
+	// Decimals is the number of fractional digits of Step: 6 for
+	// "0.000001", 0 for "1" and -1 for "10", i.e. the negated decimal
+	// exponent of power-of-ten steps.
+	Decimals int
+}
+
+// ParsePrecision parses a published precision step.
+func ParsePrecision(value string) (Precision, error) {
+	step, ok := new(big.Rat).SetString(strings.TrimSpace(value))
+	if !ok || step.Sign() <= 0 {
+		return Precision{}, fmt.Errorf("invalid precision %q", value)
+	}
+
+	decimals := 0
+	for scaled := new(big.Rat).Set(step); !scaled.IsInt(); decimals++ {
+		scaled.Mul(scaled, big.NewRat(10, 1))
+	}
+	if decimals == 0 {
+		ten := big.NewInt(10)
+		for n := new(big.Int).Set(step.Num()); n.Sign() > 0 && new(big.Int).Mod(n, ten).Sign() == 0; n.Quo(n, ten) {
+			decimals--
+		}
+	}
+	return Precision{Value: value, Step: step, Decimals: decimals}, nil
+}
+
+// UnmarshalJSON decodes the payload and parses the precision maps into
+// AmountSteps and PriceSteps. Malformed entries are left out of the
+// parsed maps rather than failing the whole configuration.
+func (n *Nobitex) UnmarshalJSON(data []byte) error {
+	type plain Nobitex
+	var decoded plain
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+	*n = Nobitex(decoded)
+
+	n.AmountSteps = parsePrecisions(n.AmountPrecisions)
+	n.PriceSteps = parsePrecisions(n.PricePrecisions)
+	return nil
+}
+
+// StepSize returns the amount step of a market ("BTCIRT") or currency
+// ("btc"), matched case-insensitively.
+func (n *Nobitex) StepSize(key string) (Precision, bool) {
+	return lookupPrecision(n.AmountSteps, key)
+}
+
+// TickSize returns the price step of a market ("BTCIRT") or quote
+// currency ("rls"), matched case-insensitively.
+func (n *Nobitex) TickSize(key string) (Precision, bool) {
+	return lookupPrecision(n.PriceSteps, key)
+}
+
+// parsePrecisions parses every valid entry of values.
+func parsePrecisions(values map[string]string) map[string]Precision {
+	parsed := make(map[string]Precision, len(values))
+	for key, value := range values {
+		if precision, err := ParsePrecision(value); err == nil {
+			parsed[key] = precision
+		}
+	}
+	return parsed
+}
+
+// lookupPrecision finds key as given, upper-cased or lower-cased.
+func lookupPrecision(precisions map[string]Precision, key string) (Precision, bool) {
+	for _, k := range []string{key, strings.ToUpper(key), strings.ToLower(key)} {
+		if precision, ok := precisions[k]; ok {
+			return precision, true
+		}
+	}
+	return Precision{}, false
+}
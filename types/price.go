@@ -0,0 +1,44 @@
+package types
+
+import (
+	"math/big"
+	"strings"
+)
+
+// MarketPrice is the sentinel Nobitex reports as the price of market
+// orders.
+const MarketPrice Price = "market"
+
+// Price is an order price as reported by Nobitex: a decimal string, or
+// MarketPrice for market orders. Use Decimal rather than parsing it
+// directly, so market orders are not mistaken for malformed prices.
+type Price string
+
+// IsMarket reports whether p is the "market" sentinel.
+func (p Price) IsMarket() bool {
+	return strings.EqualFold(strings.TrimSpace(string(p)), string(MarketPrice))
+}
+
+// Decimal parses p as an exact decimal. It reports false for market
+// orders, empty prices and malformed values.
+func (p Price) Decimal() (*big.Rat, bool) {
+	if p == "" || p.IsMarket() {
+		return nil, false
+	}
+	return new(big.Rat).SetString(strings.TrimSpace(string(p)))
+}
+
+// String returns the price as sent by Nobitex.
+func (p Price) String() string {
+	return string(p)
+}
+
+// UnmarshalJSON accepts the price as a JSON string or number.
+func (p *Price) UnmarshalJSON(data []byte) error {
+	s, err := decodeNumeric(data)
+	if err != nil {
+		return err
+	}
+	*p = Price(s)
+	return nil
+}
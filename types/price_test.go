@@ -0,0 +1,49 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestPrice(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    Price
+		market  bool
+		decimal string
+	}{
+		{`"6500000000"`, "6500000000", false, "6500000000"},
+		{`6500000000`, "6500000000", false, "6500000000"},
+		{`"0.00012"`, "0.00012", false, "3/25000"},
+		{`"market"`, MarketPrice, true, ""},
+		{`"Market"`, "Market", true, ""},
+		{`null`, "", false, ""},
+		{`""`, "", false, ""},
+	}
+	for _, tt := range tests {
+		var p Price
+		if err := json.Unmarshal([]byte(tt.input), &p); err != nil {
+			t.Errorf("Unmarshal(%s): %v", tt.input, err)
+			continue
+		}
+		if p != tt.want {
+			t.Errorf("Unmarshal(%s) = %q, want %q", tt.input, p, tt.want)
+		}
+		if p.IsMarket() != tt.market {
+			t.Errorf("Price(%q).IsMarket() = %v, want %v", p, p.IsMarket(), tt.market)
+		}
+
+		d, ok := p.Decimal()
+		switch {
+		case tt.decimal == "" && ok:
+			t.Errorf("Price(%q).Decimal() = %s, want none", p, d.RatString())
+		case tt.decimal != "" && (!ok || d.RatString() != tt.decimal):
+			t.Errorf("Price(%q).Decimal() = %v, %v, want %s", p, d, ok, tt.decimal)
+		}
+	}
+
+	var p Price
+	if err := json.Unmarshal([]byte(`true`), &p); err == nil {
+		t.Error("Unmarshal(true) decoded a boolean as a price")
+	}
+}
@@ -14,8 +14,8 @@ type CreateOrderResponse struct {
 	// DstCurrency is the quote asset of the trading pair.
 	DstCurrency string `json:"dstCurrency"`
 
-	// Price is the order price, represented as a string for precision.
-	Price string `json:"price"`
+	// Price is the order price, or MarketPrice for market orders.
+	Price Price `json:"price"`
 
 	// Amount is the quantity of the base asset submitted with the order.
 	Amount string `json:"amount"`
@@ -42,8 +42,8 @@ type CreateOrderResponse struct {
 	// CreatedAt is the timestamp when the order was created.
 	CreatedAt time.Time `json:"created_at"`
 
-	// Partial indicates partial execution details as returned by the API.
-	Partial string `json:"partial"`
+	// Partial reports whether the order has been partially filled.
+	Partial FlexBool `json:"partial"`
 
 	// Fee is the total fee charged for executions on this order.
 	Fee string `json:"fee"`
@@ -95,8 +95,8 @@ type GetOrderStatusParams struct {
 type OrderStatusResponse struct {
 	UnmatchedAmount string    `json:"unmatchedAmount"`
 	Fee             string    `json:"fee"`
-	Partial         bool      `json:"partial"`
-	Price           string    `json:"price"`
+	Partial         FlexBool  `json:"partial"`
+	Price           Price     `json:"price"`
 	CreatedAt       time.Time `json:"created_at"`
 	Id              int       `json:"id"`
 	SrcCurrency     string    `json:"srcCurrency"`
@@ -118,9 +118,23 @@ type GetOrdersListParams struct {
 	TradeType   string `json:"tradeType"`
 	SrcCurrency string `json:"srcCurrency"`
 	DstCurrency string `json:"dstCurrency"`
-	Details     int64  `json:"details"`
+	Details     int64  `json:"details"` // OrderDetailsBasic or OrderDetailsWithTrades
 	FromId      int64  `json:"fromId"`
-	Order       string `json:"order"`
+
+	// Order sorts the listing; see the Sort* constants. Empty uses the
+	// API default (newest first).
+	Order OrderSort `json:"order"`
+
+	// Page selects the 1-based result page and PageSize the number of
+	// orders per page (Nobitex caps it at 1000). Zero uses the API defaults.
+	Page     int `json:"page,omitempty"`
+	PageSize int `json:"pageSize,omitempty"`
+
+	// From and To bound the listing by order creation time (inclusive).
+	// The endpoint has no date filter, so they are applied client-side to
+	// each fetched page and never sent to Nobitex. Zero values are open.
+	From time.Time `json:"-"`
+	To   time.Time `json:"-"`
 }
 
 // OrdersListResponse represents a single entry in an order list,
@@ -132,13 +146,74 @@ type OrdersListResponse struct {
 	Status        string    `json:"status,omitempty"`
 	SrcCurrency   string    `json:"srcCurrency"`
 	DstCurrency   string    `json:"dstCurrency"`
-	Price         string    `json:"price"`
+	Price         Price     `json:"price"`
 	Amount        string    `json:"amount"`
 	MatchedAmount string    `json:"matchedAmount"`
 	AveragePrice  string    `json:"averagePrice,omitempty"`
 	Fee           string    `json:"fee,omitempty"`
 	ClientOrderId string    `json:"clientOrderId"`
 	CreatedAt     time.Time `json:"created_at,omitempty"`
+
+	// Trades lists the fills of the order. Nobitex only embeds it when the
+	// listing is requested with Details=OrderDetailsWithTrades.
+	Trades []OrderTrade `json:"trades,omitempty"`
+}
+
+// Values accepted by GetOrdersListParams.Details.
+const (
+	// OrderDetailsBasic returns the summary fields of each order.
+	OrderDetailsBasic int64 = 1
+
+	// OrderDetailsWithTrades additionally embeds the matched trades of
+	// each order in OrdersListResponse.Trades.
+	OrderDetailsWithTrades int64 = 2
+)
+
+// OrderSort is a sort key accepted by GetOrdersListParams.Order. A
+// leading "-" sorts descending.
+type OrderSort string
+
+// Sort keys supported by /market/orders/list. Nobitex only sorts by id,
+// creation time and price; other fields are rejected by the API.
+const (
+	SortIdAsc         OrderSort = "id"
+	SortIdDesc        OrderSort = "-id"
+	SortCreatedAtAsc  OrderSort = "created_at"
+	SortCreatedAtDesc OrderSort = "-created_at"
+	SortPriceAsc      OrderSort = "price"
+	SortPriceDesc     OrderSort = "-price"
+)
+
+// Valid reports whether s is empty or one of the Sort* constants.
+func (s OrderSort) Valid() bool {
+	switch s {
+	case "", SortIdAsc, SortIdDesc, SortCreatedAtAsc, SortCreatedAtDesc, SortPriceAsc, SortPriceDesc:
+		return true
+	default:
+		return false
+	}
+}
+
+// OrderTrade is a single fill embedded in an order listing requested with
+// Details=OrderDetailsWithTrades.
+type OrderTrade struct {
+	// Id is the unique identifier of the trade.
+	Id int `json:"id"`
+
+	// Price is the execution price of the fill.
+	Price string `json:"price"`
+
+	// Amount is the filled quantity of the base currency.
+	Amount string `json:"amount"`
+
+	// Total is the quote-currency value of the fill.
+	Total string `json:"total"`
+
+	// Fee is the fee charged for this fill.
+	Fee string `json:"fee"`
+
+	// Timestamp is when the fill was executed.
+	Timestamp time.Time `json:"timestamp"`
 }
 
 // UserTradeResponse represents an executed trade associated with an order,
@@ -153,7 +228,7 @@ type UserTradeResponse struct {
 	Type        string    `json:"type"`
 	Price       string    `json:"price"`
 	Amount      string    `json:"amount"`
-	Total       int       `json:"total"`
+	Total       Decimal   `json:"total"`
 	Fee         string    `json:"fee"`
 }
 
@@ -163,16 +238,30 @@ type GetUserTradesParams struct {
 	SrcCurrency string `json:"srcCurrency,omitempty"`
 	DstCurrency string `json:"dstCurrency,omitempty"`
 	FromId      string `json:"fromId,omitempty"`
+
+	// Page selects the 1-based result page and PageSize the number of
+	// trades per page. Zero uses the API defaults.
+	Page     int `json:"page,omitempty"`
+	PageSize int `json:"pageSize,omitempty"`
+
+	// From and To bound the listing by trade timestamp (inclusive).
+	// /market/trades/list has no date filter, so they are never sent to
+	// Nobitex: the trade iterators seek to From by trade id and stop after
+	// To, while single-page calls filter each page. Zero values are open.
+	From time.Time `json:"-"`
+	To   time.Time `json:"-"`
 }
 
 // CreateOrderStatus wraps a single order response together with a status field.
 type CreateOrderStatus struct {
+	// Status indicates the result of the request.
 	Status      string              `json:"status"`
 	OrderStatus CreateOrderResponse `json:"order"`
 }
 
 // UserTrades represents a collection of user trades, along with pagination info.
 type UserTrades struct {
+	// Status indicates the result of the request.
 	Status  string              `json:"status"`
 	Trades  []UserTradeResponse `json:"trades"`
 	HasNext bool                `json:"hasNext"`
@@ -180,12 +269,44 @@ type UserTrades struct {
 
 // OrderStatusList represents a list of orders together with a status indicator.
 type OrderStatusList struct {
+	// Status indicates the result of the request.
 	Status string               `json:"status"`
 	Orders []OrdersListResponse `json:"orders"`
+
+	// HasNext reports whether another page is available when the listing
+	// was requested with Page/PageSize.
+	HasNext bool `json:"hasNext"`
 }
 
 // OrderStatus wraps a single order status entry.
 type OrderStatus struct {
+	// Status indicates the result of the request.
 	Status string              `json:"status"`
 	Order  OrderStatusResponse `json:"order"`
 }
+
+// OpenOrdersSummary aggregates the user's open orders.
+type OpenOrdersSummary struct {
+	// Count is the number of open orders.
+	Count int
+
+	// Markets breaks the orders down by market symbol, e.g. "BTCIRT".
+	Markets map[string]MarketOrdersSummary
+
+	// Oldest is the open order created first, nil without open orders.
+	Oldest *OrdersListResponse
+}
+
+// MarketOrdersSummary aggregates the open orders of one market.
+type MarketOrdersSummary struct {
+	// Buys and Sells count the open orders per side.
+	Buys  int
+	Sells int
+
+	// LockedBase is the unmatched base amount of sell orders.
+	LockedBase string
+
+	// LockedQuote is the unmatched value of buy orders at their limit
+	// price, in the quote currency. Market buys are not included.
+	LockedQuote string
+}
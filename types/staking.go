@@ -0,0 +1,158 @@
+package types
+
+import "time"
+
+// GetStakingPlansParams filters the listing of staking and yield plans.
+type GetStakingPlansParams struct {
+	// Type restricts the listing to "staking" or "yield_aggregator".
+	// Empty lists every plan.
+	Type string `json:"type,omitempty"`
+
+	// Currency restricts the listing to plans of one asset, e.g. "eth".
+	Currency string `json:"currency,omitempty"`
+}
+
+// StakingPlan describes a staking or yield product users can subscribe to.
+type StakingPlan struct {
+	// Id is the unique identifier of the plan.
+	Id int `json:"id"`
+
+	// Type is the product family, e.g. "staking" or "yield_aggregator".
+	Type string `json:"type"`
+
+	// Currency is the staked asset.
+	Currency string `json:"currency"`
+
+	// EstimatedAPR is the advertised annual percentage rate.
+	EstimatedAPR string `json:"estimatedAPR"`
+
+	// StakingPeriod is the lock-up length in days.
+	StakingPeriod int `json:"stakingPeriod"`
+
+	// UnstakingPeriod is the release delay after redemption, in days.
+	UnstakingPeriod int `json:"unstakingPeriod"`
+
+	// MinAmount is the smallest accepted subscription.
+	MinAmount string `json:"minAmount"`
+
+	// MaxAmount is the largest accepted subscription per user.
+	MaxAmount string `json:"maxAmount,omitempty"`
+
+	// Capacity is the total amount the plan accepts.
+	Capacity string `json:"capacity"`
+
+	// FilledCapacity is the amount already subscribed by all users.
+	FilledCapacity string `json:"filledCapacity"`
+
+	// IsOpen reports whether the plan currently accepts subscriptions.
+	IsOpen bool `json:"isOpen"`
+
+	// OpenedAt is when the plan started accepting subscriptions.
+	OpenedAt time.Time `json:"openedAt"`
+}
+
+// StakingPlans is the list of available plans.
+type StakingPlans struct {
+	// Status indicates the result of the request.
+	Status string `json:"status"`
+
+	// Plans lists the available plans.
+	Plans []StakingPlan `json:"plans"`
+}
+
+// StakingSubscribeParams subscribes an amount to a plan.
+type StakingSubscribeParams struct {
+	// PlanId is StakingPlan.Id of the plan to join.
+	PlanId int `json:"planId"`
+
+	// Amount is the quantity of the plan currency to stake.
+	Amount string `json:"amount"`
+
+	// AllowExtend renews the subscription automatically at the end of the
+	// staking period.
+	AllowExtend bool `json:"allowExtend,omitempty"`
+}
+
+// StakingRedeemParams requests the release of a staked amount.
+type StakingRedeemParams struct {
+	// PlanId is StakingPlan.Id of the subscribed plan.
+	PlanId int `json:"planId"`
+
+	// Amount is the quantity to redeem.
+	Amount string `json:"amount"`
+}
+
+// StakingSubscription is a user's position in a plan.
+type StakingSubscription struct {
+	// Id is the unique identifier of the subscription.
+	Id int `json:"id"`
+
+	// PlanId identifies the plan.
+	PlanId int `json:"planId"`
+
+	// Currency is the staked asset.
+	Currency string `json:"currency"`
+
+	// Amount is the currently staked quantity.
+	Amount string `json:"amount"`
+
+	// Status is the lifecycle state, e.g. "staked" or "released".
+	Status string `json:"status"`
+
+	// AllowExtend reports whether the subscription renews automatically.
+	AllowExtend bool `json:"allowExtend"`
+
+	// CreatedAt is when the subscription was made.
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// StakingSubscriptionResponse wraps a subscription together with a status field.
+type StakingSubscriptionResponse struct {
+	// Status indicates the result of the request.
+	Status string `json:"status"`
+
+	// Subscription is the affected subscription.
+	Subscription StakingSubscription `json:"subscription"`
+}
+
+// GetStakingRewardsParams filters the reward history.
+type GetStakingRewardsParams struct {
+	// PlanId restricts the history to one plan. Optional.
+	PlanId int `json:"planId,omitempty"`
+
+	// Page selects the 1-based result page. Defaults to the first page.
+	Page int `json:"page,omitempty"`
+
+	// PageSize limits the number of rewards per page.
+	PageSize int `json:"pageSize,omitempty"`
+}
+
+// StakingReward is a single reward credited by a plan.
+type StakingReward struct {
+	// Id is the unique identifier of the reward.
+	Id int `json:"id"`
+
+	// PlanId identifies the plan that paid the reward.
+	PlanId int `json:"planId"`
+
+	// Currency is the asset the reward was paid in.
+	Currency string `json:"currency"`
+
+	// Amount is the credited quantity.
+	Amount string `json:"amount"`
+
+	// CreatedAt is when the reward was credited.
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// StakingRewards is a page of reward records.
+type StakingRewards struct {
+	// Status indicates the result of the request.
+	Status string `json:"status"`
+
+	// Rewards lists the reward records of the page.
+	Rewards []StakingReward `json:"rewards"`
+
+	// HasNext reports whether another page is available.
+	HasNext bool `json:"hasNext"`
+}
@@ -0,0 +1,78 @@
+// Code generated by genstatus; DO NOT EDIT.
+
+package types
+
+// GetStatus returns the response status, "ok" on success.
+func (r AuthenticationResponse) GetStatus() string { return r.Status }
+
+// GetStatus returns the response status, "ok" on success.
+func (r CancelOrderResponse) GetStatus() string { return r.Status }
+
+// GetStatus returns the response status, "ok" on success.
+func (r ConvertQuoteResponse) GetStatus() string { return r.Status }
+
+// GetStatus returns the response status, "ok" on success.
+func (r ConvertTradeResponse) GetStatus() string { return r.Status }
+
+// GetStatus returns the response status, "ok" on success.
+func (r CreateOrderStatus) GetStatus() string { return r.Status }
+
+// GetStatus returns the response status, "ok" on success.
+func (r Deposits) GetStatus() string { return r.Status }
+
+// GetStatus returns the response status, "ok" on success.
+func (r LoanPlans) GetStatus() string { return r.Status }
+
+// GetStatus returns the response status, "ok" on success.
+func (r LoanResponse) GetStatus() string { return r.Status }
+
+// GetStatus returns the response status, "ok" on success.
+func (r Loans) GetStatus() string { return r.Status }
+
+// GetStatus returns the response status, "ok" on success.
+func (r OrderBook) GetStatus() string { return r.Status }
+
+// GetStatus returns the response status, "ok" on success.
+func (r OrderStatus) GetStatus() string { return r.Status }
+
+// GetStatus returns the response status, "ok" on success.
+func (r OrderStatusList) GetStatus() string { return r.Status }
+
+// GetStatus returns the response status, "ok" on success.
+func (r RialDepositStatus) GetStatus() string { return r.Status }
+
+// GetStatus returns the response status, "ok" on success.
+func (r ShetabDeposit) GetStatus() string { return r.Status }
+
+// GetStatus returns the response status, "ok" on success.
+func (r StakingPlans) GetStatus() string { return r.Status }
+
+// GetStatus returns the response status, "ok" on success.
+func (r StakingRewards) GetStatus() string { return r.Status }
+
+// GetStatus returns the response status, "ok" on success.
+func (r StakingSubscriptionResponse) GetStatus() string { return r.Status }
+
+// GetStatus returns the response status, "ok" on success.
+func (r SystemStatus) GetStatus() string { return r.Status }
+
+// GetStatus returns the response status, "ok" on success.
+func (r Tickers) GetStatus() string { return r.Status }
+
+// GetStatus returns the response status, "ok" on success.
+func (r Trades) GetStatus() string { return r.Status }
+
+// GetStatus returns the response status, "ok" on success.
+func (r UserTrades) GetStatus() string { return r.Status }
+
+// GetStatus returns the response status, "ok" on success.
+func (r Wallets) GetStatus() string { return r.Status }
+
+// GetStatus returns the response status, "ok" on success.
+func (r WalletsV3) GetStatus() string { return r.Status }
+
+// GetStatus returns the response status, "ok" on success.
+func (r WithdrawalStatus) GetStatus() string { return r.Status }
+
+// GetStatus returns the response status, "ok" on success.
+func (r Withdrawals) GetStatus() string { return r.Status }
@@ -0,0 +1,26 @@
+package types
+
+import "time"
+
+// SystemStatus reports the availability of the Nobitex API and any
+// published maintenance window.
+type SystemStatus struct {
+	// Status indicates the result of the request.
+	Status string `json:"status"`
+
+	// IsMaintenance reports whether the exchange is in maintenance mode.
+	// Trading endpoints reject orders while it is set.
+	IsMaintenance bool `json:"isMaintenance"`
+
+	// Message is the maintenance notice published by Nobitex, if any.
+	Message string `json:"message,omitempty"`
+
+	// MaintenanceStart and MaintenanceEnd bound a scheduled maintenance
+	// window. Both are zero when none is announced.
+	MaintenanceStart time.Time `json:"maintenanceStart,omitempty"`
+	MaintenanceEnd   time.Time `json:"maintenanceEnd,omitempty"`
+
+	// Latency is the round-trip time of the status request measured by the
+	// client. It is not part of the API response.
+	Latency time.Duration `json:"-"`
+}
@@ -0,0 +1,269 @@
+package types
+
+import (
+	"strings"
+	"time"
+)
+
+// Deposit represents a single deposit credited (or pending credit) to one
+// of the user's wallets, either on-chain or via rial transfer.
+type Deposit struct {
+	// Id is the unique identifier of the deposit.
+	Id int `json:"id"`
+
+	// WalletId identifies the wallet the deposit was credited to.
+	WalletId int `json:"walletId"`
+
+	// Currency is the deposited asset symbol, e.g. "btc" or "rls".
+	Currency string `json:"currency"`
+
+	// Amount is the deposited quantity.
+	Amount string `json:"amount"`
+
+	// Status is the credit state reported by Nobitex, e.g. "Confirmed".
+	Status string `json:"status"`
+
+	// TxHash is the on-chain transaction hash, empty for rial deposits.
+	TxHash string `json:"txHash,omitempty"`
+
+	// Address is the deposit address the funds were sent to.
+	Address string `json:"address,omitempty"`
+
+	// Tag is the memo/destination tag for networks that require one.
+	Tag string `json:"tag,omitempty"`
+
+	// Network is the blockchain network the deposit arrived on.
+	Network Network `json:"network,omitempty"`
+
+	// IsConfirmed reports whether the deposit reached the required
+	// number of confirmations and has been credited.
+	IsConfirmed bool `json:"isConfirmed"`
+
+	// Confirmations is the number of confirmations observed so far.
+	Confirmations int `json:"confirmations"`
+
+	// RequiredConfirmation is the number of confirmations needed for credit.
+	RequiredConfirmation int `json:"requiredConfirmation"`
+
+	// BlockchainUrl links to the transaction in a block explorer.
+	BlockchainUrl string `json:"blockchainUrl,omitempty"`
+
+	// Date is when the deposit was detected.
+	Date time.Time `json:"date"`
+}
+
+// GetDepositsParams defines the filters for listing deposits.
+type GetDepositsParams struct {
+	// Wallet restricts the listing to a single wallet id. Optional.
+	Wallet int `json:"wallet,omitempty"`
+
+	// Page selects the 1-based result page. Defaults to the first page.
+	Page int `json:"page,omitempty"`
+
+	// PageSize limits the number of deposits per page.
+	PageSize int `json:"pageSize,omitempty"`
+}
+
+// Deposits is a page of deposit records.
+type Deposits struct {
+	// Status indicates the result of the request.
+	Status string `json:"status"`
+
+	// Deposits lists the deposit records of the page.
+	Deposits []Deposit `json:"deposits"`
+
+	// HasNext reports whether another page is available.
+	HasNext bool `json:"hasNext"`
+}
+
+// Withdrawal represents a single withdrawal request and its processing state.
+type Withdrawal struct {
+	// Id is the unique identifier of the withdrawal.
+	Id int `json:"id"`
+
+	// WalletId identifies the wallet the funds are withdrawn from.
+	WalletId int `json:"wallet_id"`
+
+	// Currency is the withdrawn asset symbol.
+	Currency string `json:"currency"`
+
+	// Amount is the requested withdrawal quantity.
+	Amount string `json:"amount"`
+
+	// Status is the processing state, e.g. "New", "Verified", "Processing",
+	// "Done" or "Rejected".
+	Status string `json:"status"`
+
+	// Address is the destination address.
+	Address string `json:"address"`
+
+	// Tag is the memo/destination tag for networks that require one.
+	Tag string `json:"tag,omitempty"`
+
+	// Network is the blockchain network used for the transfer.
+	Network Network `json:"network,omitempty"`
+
+	// TxHash is the on-chain transaction hash once broadcast.
+	TxHash string `json:"txHash,omitempty"`
+
+	// BlockchainUrl links to the transaction in a block explorer.
+	BlockchainUrl string `json:"blockchain_url,omitempty"`
+
+	// IsCancelable reports whether the withdrawal can still be canceled.
+	IsCancelable bool `json:"is_cancelable"`
+
+	// CreatedAt is when the withdrawal was requested.
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// WithdrawalState is the coarse lifecycle stage of a withdrawal, derived
+// from the more detailed Status reported by Nobitex.
+type WithdrawalState string
+
+const (
+	// WithdrawalPending covers requests not yet picked up for sending
+	// ("New", "Verified", "Accepted").
+	WithdrawalPending WithdrawalState = "pending"
+
+	// WithdrawalProcessing covers requests being sent or awaiting
+	// confirmation ("Processing", "Manual Accepted", "Sent").
+	WithdrawalProcessing WithdrawalState = "processing"
+
+	// WithdrawalDone means the transfer completed.
+	WithdrawalDone WithdrawalState = "done"
+
+	// WithdrawalRejected covers rejected and canceled requests.
+	WithdrawalRejected WithdrawalState = "rejected"
+
+	// WithdrawalUnknown is returned for statuses this package does not
+	// recognize.
+	WithdrawalUnknown WithdrawalState = "unknown"
+)
+
+// State maps Status to a WithdrawalState, case-insensitively.
+func (w Withdrawal) State() WithdrawalState {
+	switch strings.ToLower(strings.TrimSpace(w.Status)) {
+	case "new", "verified", "accepted", "waiting":
+		return WithdrawalPending
+	case "processing", "manual accepted", "sent":
+		return WithdrawalProcessing
+	case "done":
+		return WithdrawalDone
+	case "rejected", "canceled", "cancelled":
+		return WithdrawalRejected
+	default:
+		return WithdrawalUnknown
+	}
+}
+
+// Final reports whether the withdrawal can no longer change state.
+func (w Withdrawal) Final() bool {
+	state := w.State()
+	return state == WithdrawalDone || state == WithdrawalRejected
+}
+
+// WithdrawalStatus wraps a single withdrawal together with a status field.
+type WithdrawalStatus struct {
+	// Status indicates the result of the request.
+	Status string `json:"status"`
+
+	// Withdrawal is the requested withdrawal.
+	Withdrawal Withdrawal `json:"withdraw"`
+}
+
+// GetWithdrawalsParams defines the filters for listing withdrawals.
+type GetWithdrawalsParams struct {
+	// Wallet restricts the listing to a single wallet id. Optional.
+	Wallet int `json:"wallet,omitempty"`
+
+	// Page selects the 1-based result page. Defaults to the first page.
+	Page int `json:"page,omitempty"`
+
+	// PageSize limits the number of withdrawals per page.
+	PageSize int `json:"pageSize,omitempty"`
+}
+
+// Withdrawals is a page of withdrawal records.
+type Withdrawals struct {
+	// Status indicates the result of the request.
+	Status string `json:"status"`
+
+	// Withdrawals lists the withdrawal records of the page.
+	Withdrawals []Withdrawal `json:"withdraws"`
+
+	// HasNext reports whether another page is available.
+	HasNext bool `json:"hasNext"`
+}
+
+// InitiateShetabDepositParams starts a rial top-up through a Shetab
+// (debit card) payment gateway.
+type InitiateShetabDepositParams struct {
+	// Amount is the deposit amount in rials.
+	Amount int64 `json:"amount"`
+
+	// SelectedCard is the id of a verified bank card the payment must be
+	// made with.
+	SelectedCard int `json:"selectedCard"`
+}
+
+// ShetabDeposit is an initiated gateway payment. The user completes it by
+// opening RedirectUrl in a browser.
+type ShetabDeposit struct {
+	// Status indicates the result of the request.
+	Status string `json:"status"`
+
+	// DepositId identifies the payment for GetRialDepositStatus.
+	DepositId int `json:"id"`
+
+	// Amount is the requested deposit amount in rials.
+	Amount int64 `json:"amount"`
+
+	// Gateway names the payment gateway chosen by Nobitex.
+	Gateway string `json:"gateway,omitempty"`
+
+	// RedirectUrl is the payment page the user must visit.
+	RedirectUrl string `json:"url"`
+}
+
+// GetRialDepositStatusParams identifies a rial deposit.
+type GetRialDepositStatusParams struct {
+	// DepositId is ShetabDeposit.DepositId.
+	DepositId int `json:"id"`
+}
+
+// RialDeposit is the state of a rial (Shetab) deposit.
+type RialDeposit struct {
+	// Id is the unique identifier of the deposit.
+	Id int `json:"id"`
+
+	// Amount is the requested amount in rials.
+	Amount int64 `json:"amount"`
+
+	// Fee is the gateway fee deducted from the deposit, in rials.
+	Fee int64 `json:"fee"`
+
+	// Status is the payment state, e.g. "new", "pending", "confirmed"
+	// or "failed".
+	Status string `json:"status"`
+
+	// CardNumber is the masked number of the card used.
+	CardNumber string `json:"cardNumber,omitempty"`
+
+	// Gateway names the payment gateway used.
+	Gateway string `json:"gateway,omitempty"`
+
+	// TrackingCode is the bank reference of a completed payment.
+	TrackingCode string `json:"trackingCode,omitempty"`
+
+	// CreatedAt is when the deposit was initiated.
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// RialDepositStatus wraps a rial deposit together with a status field.
+type RialDepositStatus struct {
+	// Status indicates the result of the request.
+	Status string `json:"status"`
+
+	// Deposit is the requested deposit.
+	Deposit RialDeposit `json:"deposit"`
+}
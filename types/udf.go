@@ -0,0 +1,155 @@
+package types
+
+import (
+	"math/big"
+	"time"
+)
+
+// Candle resolutions accepted by the UDF history endpoint. Minute
+// resolutions are plain numbers; daily ones use a "D" suffix.
+const (
+	Resolution1m  = "1"
+	Resolution5m  = "5"
+	Resolution15m = "15"
+	Resolution30m = "30"
+	Resolution1h  = "60"
+	Resolution3h  = "180"
+	Resolution4h  = "240"
+	Resolution6h  = "360"
+	Resolution12h = "720"
+	Resolution1D  = "D"
+	Resolution2D  = "2D"
+	Resolution3D  = "3D"
+)
+
+// Resolutions lists every resolution supported by Nobitex, shortest first.
+var Resolutions = []string{
+	Resolution1m, Resolution5m, Resolution15m, Resolution30m, Resolution1h,
+	Resolution3h, Resolution4h, Resolution6h, Resolution12h, Resolution1D,
+	Resolution2D, Resolution3D,
+}
+
+// GetCandlesParams selects the OHLCV history of one market.
+type GetCandlesParams struct {
+	// Symbol is the market symbol, e.g. "BTCIRT".
+	Symbol string `json:"symbol"`
+
+	// Resolution is one of the Resolution* constants.
+	Resolution string `json:"resolution"`
+
+	// From and To bound the range as unix seconds. To is required.
+	From int64 `json:"from,omitempty"`
+	To   int64 `json:"to"`
+
+	// CountBack requests this many bars ending at To, taking precedence
+	// over From.
+	CountBack int `json:"countback,omitempty"`
+
+	// Page selects the 1-based page of a long range.
+	Page int `json:"page,omitempty"`
+}
+
+// CandleHistory is the column-oriented UDF history response.
+type CandleHistory struct {
+	// Status is "ok", "no_data" or "error".
+	Status string `json:"s"`
+
+	// ErrMsg describes an "error" status.
+	ErrMsg string `json:"errmsg,omitempty"`
+
+	// NextTime is the unix time of the closest earlier bar, sent with a
+	// "no_data" status.
+	NextTime int64 `json:"nextTime,omitempty"`
+
+	// Time holds the bar open times as unix seconds; the remaining
+	// columns are aligned with it.
+	Time   []int64   `json:"t"`
+	Open   []float64 `json:"o"`
+	High   []float64 `json:"h"`
+	Low    []float64 `json:"l"`
+	Close  []float64 `json:"c"`
+	Volume []float64 `json:"v"`
+}
+
+// Candle is one OHLCV bar.
+type Candle struct {
+	Time   time.Time `json:"time"`
+	Open   float64   `json:"open"`
+	High   float64   `json:"high"`
+	Low    float64   `json:"low"`
+	Close  float64   `json:"close"`
+	Volume float64   `json:"volume"`
+}
+
+// Bars converts the columns into a slice of candles. Rows missing from a
+// shorter column are dropped.
+func (h *CandleHistory) Bars() []Candle {
+	n := len(h.Time)
+	for _, column := range [][]float64{h.Open, h.High, h.Low, h.Close, h.Volume} {
+		n = min(n, len(column))
+	}
+
+	bars := make([]Candle, n)
+	for i := range bars {
+		bars[i] = Candle{
+			Time:   time.Unix(h.Time[i], 0),
+			Open:   h.Open[i],
+			High:   h.High[i],
+			Low:    h.Low[i],
+			Close:  h.Close[i],
+			Volume: h.Volume[i],
+		}
+	}
+	return bars
+}
+
+// SymbolInfo is the UDF description of a market, as served by
+// /market/udf/symbols and expected by the TradingView charting library.
+type SymbolInfo struct {
+	// Status is "error" when the symbol is unknown; empty otherwise.
+	Status string `json:"s,omitempty"`
+
+	// ErrMsg describes an "error" status.
+	ErrMsg string `json:"errmsg,omitempty"`
+
+	Name           string `json:"name"`
+	Ticker         string `json:"ticker"`
+	Description    string `json:"description"`
+	Type           string `json:"type"`
+	Session        string `json:"session"`
+	Timezone       string `json:"timezone"`
+	Exchange       string `json:"exchange"`
+	ListedExchange string `json:"listed_exchange"`
+
+	// MinMov and PriceScale define the tick size as MinMov/PriceScale.
+	MinMov     int `json:"minmov"`
+	PriceScale int `json:"pricescale"`
+
+	HasIntraday          bool     `json:"has_intraday"`
+	HasDaily             bool     `json:"has_daily"`
+	SupportedResolutions []string `json:"supported_resolutions"`
+	VolumePrecision      int      `json:"volume_precision"`
+	DataStatus           string   `json:"data_status"`
+}
+
+// TickSize returns the minimum price increment, MinMov/PriceScale, as an
+// exact decimal such as "0.01". It is empty when PriceScale is unset.
+func (s SymbolInfo) TickSize() string {
+	if s.PriceScale <= 0 {
+		return ""
+	}
+	minMov := s.MinMov
+	if minMov <= 0 {
+		minMov = 1
+	}
+
+	tick := big.NewRat(int64(minMov), int64(s.PriceScale))
+	if tick.IsInt() {
+		return tick.Num().String()
+	}
+	decimals := 0
+	for scale := s.PriceScale; scale > 1; scale /= 10 {
+		decimals++
+	}
+	return tick.FloatString(decimals)
+}
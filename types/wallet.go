@@ -1,5 +1,11 @@
 package types
 
+import (
+	"encoding/json"
+	"math/big"
+	"strings"
+)
+
 // Wallet represents a user’s wallet entry for a specific currency,
 // including available and blocked balances.
 type Wallet struct {
@@ -18,9 +24,9 @@ type Wallet struct {
 // GetWalletParams defines optional filters for retrieving wallet data,
 // such as narrowing results by currency or trade type.
 type GetWalletParams struct {
-	// Currencies specifies a list of asset symbols to filter on.
-	// If empty, all wallets are returned.
-	Currencies []string `json:"assets,omitempty"`
+	// Currencies specifies a list of asset symbols to filter on, sent as a
+	// comma-separated "currencies" parameter. If empty, all wallets are returned.
+	Currencies []string `json:"currencies,omitempty"`
 
 	// TradeType restricts results to wallets associated with a specific
 	// market type, such as 'spot' or 'margin'.
@@ -36,3 +42,179 @@ type Wallets struct {
 	// Wallets is a map of currency symbols to their corresponding wallet data.
 	Wallets map[string]Wallet `json:"wallets"`
 }
+
+// legacyWallet is an element of the array returned by the legacy
+// /users/wallets/list endpoint.
+type legacyWallet struct {
+	Id             int    `json:"id"`
+	Currency       string `json:"currency"`
+	Balance        string `json:"balance"`
+	BlockedBalance string `json:"blockedBalance"`
+}
+
+// UnmarshalJSON accepts both wallet listing shapes: the v2 object keyed
+// by currency and the legacy /users/wallets/list array, whose entries
+// carry their currency and report "blockedBalance" instead of "blocked".
+// Array entries are keyed by their lower-cased currency.
+func (w *Wallets) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		Status  string          `json:"status"`
+		Wallets json.RawMessage `json:"wallets"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	w.Status = raw.Status
+	w.Wallets = nil
+
+	body := strings.TrimSpace(string(raw.Wallets))
+	switch {
+	case body == "" || body == "null":
+		return nil
+	case body[0] == '[':
+		var list []legacyWallet
+		if err := json.Unmarshal(raw.Wallets, &list); err != nil {
+			return err
+		}
+		w.Wallets = make(map[string]Wallet, len(list))
+		for _, wallet := range list {
+			w.Wallets[strings.ToLower(wallet.Currency)] = Wallet{
+				Id:      wallet.Id,
+				Balance: wallet.Balance,
+				Blocked: wallet.BlockedBalance,
+			}
+		}
+		return nil
+	default:
+		return json.Unmarshal(raw.Wallets, &w.Wallets)
+	}
+}
+
+// WalletV3 is a wallet entry of the v3 wallets endpoint, which extends
+// the v2 fields with rial valuation and daily change.
+type WalletV3 struct {
+	// Id is the unique identifier assigned to this wallet entry.
+	Id int `json:"id"`
+
+	// Currency is the lower-case asset symbol, e.g. "btc".
+	Currency string `json:"currency"`
+
+	// Balance is the full balance of the wallet, including blocked funds.
+	Balance string `json:"balance"`
+
+	// Blocked is the portion of the balance locked by open orders,
+	// withdrawals or system holds.
+	Blocked string `json:"blocked"`
+
+	// ActiveBalance is Balance minus Blocked.
+	ActiveBalance string `json:"activeBalance"`
+
+	// RialBalance is the balance valued at the current buy price, in rials.
+	RialBalance string `json:"rialBalance"`
+
+	// RialBalanceSell is the balance valued at the current sell price,
+	// in rials.
+	RialBalanceSell string `json:"rialBalanceSell"`
+
+	// DailyChange is the 24h change of the asset price, in percent.
+	DailyChange string `json:"dailyChange"`
+
+	// DepositAddress is the wallet's default deposit address, if any.
+	DepositAddress string `json:"depositAddress,omitempty"`
+}
+
+// WalletsV3 is the response of the v3 wallets endpoint, keyed by
+// currency symbol.
+type WalletsV3 struct {
+	// Status indicates the result of the wallet retrieval operation.
+	Status string `json:"status"`
+
+	// Wallets is a map of currency symbols to their wallet data.
+	Wallets map[string]WalletV3 `json:"wallets"`
+}
+
+// V2 reduces the response to the v2 Wallets shape, so code written
+// against GetWallets can consume either version.
+func (w WalletsV3) V2() Wallets {
+	wallets := Wallets{Status: w.Status, Wallets: make(map[string]Wallet, len(w.Wallets))}
+	for currency, wallet := range w.Wallets {
+		wallets.Wallets[currency] = Wallet{Id: wallet.Id, Balance: wallet.Balance, Blocked: wallet.Blocked}
+	}
+	return wallets
+}
+
+// Balance is the parsed wallet of a single currency.
+type Balance struct {
+	// Currency is the lower-case asset symbol, e.g. "btc".
+	Currency string
+
+	// WalletId is the id of the wallet.
+	WalletId int
+
+	// Total is the full balance, including blocked funds.
+	Total *big.Rat
+
+	// Blocked is the part of Total locked by open orders, pending
+	// withdrawals or system holds.
+	Blocked *big.Rat
+
+	// Available is Total minus Blocked, never negative.
+	Available *big.Rat
+}
+
+// PortfolioValuation is the value of every non-empty wallet expressed in
+// a single quote currency.
+type PortfolioValuation struct {
+	// Quote is the currency values are expressed in: "usdt", "rls" or
+	// "irt" (toman, a tenth of the rial value).
+	Quote string
+
+	// Total is the summed value of the priced assets.
+	Total *big.Rat
+
+	// Assets lists the priced wallets, most valuable first.
+	Assets []AssetValue
+
+	// Unpriced lists currencies held for which no market path to Quote
+	// exists; they are not included in Total.
+	Unpriced []string
+}
+
+// AssetValue is the valuation of one wallet.
+type AssetValue struct {
+	// Currency is the lower-case asset symbol, e.g. "btc".
+	Currency string
+
+	// Amount is the full balance, including blocked funds.
+	Amount *big.Rat
+
+	// Price is the value of one unit of Currency in the quote currency.
+	Price *big.Rat
+
+	// Value is Amount × Price.
+	Value *big.Rat
+
+	// Weight is Value's share of the portfolio total, between 0 and 1.
+	Weight float64
+
+	// Path lists the currencies the price was derived through, e.g.
+	// ["ton", "usdt", "rls"] when no direct TON/IRT market exists.
+	Path []string
+}
+
+// AggregatedBalance is the balance of one currency summed over several
+// accounts.
+type AggregatedBalance struct {
+	// Currency is the lower-case asset symbol, e.g. "btc".
+	Currency string
+
+	// Total, Blocked and Available are summed over Accounts.
+	Total     *big.Rat
+	Blocked   *big.Rat
+	Available *big.Rat
+
+	// Accounts holds the balance of each account holding the currency,
+	// keyed by account name.
+	Accounts map[string]*Balance
+}
@@ -0,0 +1,112 @@
+package nobitex
+
+import (
+	"net/http"
+
+	t "github.com/darhelm/go-nobitex/types"
+)
+
+// GetCandles retrieves OHLCV history of a market from the UDF endpoint
+// Nobitex serves for TradingView.
+//
+// Endpoint:
+//
+//	GET /market/udf/history
+//
+// Parameters:
+//   - params: t.GetCandlesParams
+//     Symbol ("BTCIRT")
+//     Resolution (t.Resolution1h, t.Resolution1D, ...)
+//     From / To (unix seconds)
+//     CountBack
+//
+// Returns:
+//   - *t.CandleHistory; use Bars() for a row-oriented view.
+//
+// Behavior:
+//   - No authentication required.
+//   - A "no_data" status is returned as-is with empty columns.
+//   - An "error" status is returned as an *APIError.
+//
+// Example:
+//
+//	history, _ := client.GetCandles(t.GetCandlesParams{
+//	    Symbol:     "BTCIRT",
+//	    Resolution: t.Resolution1h,
+//	    To:         time.Now().Unix(),
+//	    CountBack:  24,
+//	})
+//	for _, bar := range history.Bars() {
+//	    fmt.Println(bar.Time, bar.Close)
+//	}
+func (c *Client) GetCandles(params t.GetCandlesParams, opts ...RequestOption) (*t.CandleHistory, error) {
+	symbol, err := NormalizeSymbol(params.Symbol)
+	if err != nil {
+		return nil, err
+	}
+	params.Symbol = symbol
+
+	var history *t.CandleHistory
+	err = c.ApiRequest("GET", "/market/udf/history", "", false, false, params, &history, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	if history.Status == "error" {
+		return nil, &APIError{
+			GoNobitexError: GoNobitexError{Message: history.ErrMsg},
+			Status:         history.Status,
+			Message:        history.ErrMsg,
+			StatusCode:     http.StatusOK,
+		}
+	}
+	return history, nil
+}
+
+// GetSymbolInfo retrieves the UDF metadata of a market: tick size
+// (MinMov/PriceScale), trading session, description and supported
+// resolutions.
+//
+// Endpoint:
+//
+//	GET /market/udf/symbols
+//
+// Parameters:
+//   - symbol: Market symbol, e.g. "BTCIRT"; case-insensitive.
+//
+// Returns:
+//   - *t.SymbolInfo; use TickSize() for the price increment as a decimal.
+//
+// Behavior:
+//   - No authentication required.
+//   - An "error" status (unknown symbol) is returned as an *APIError.
+//
+// Example:
+//
+//	info, _ := client.GetSymbolInfo("BTCIRT")
+//	fmt.Println(info.Description, info.Session, info.TickSize())
+func (c *Client) GetSymbolInfo(symbol string, opts ...RequestOption) (*t.SymbolInfo, error) {
+	symbol, err := NormalizeSymbol(symbol)
+	if err != nil {
+		return nil, err
+	}
+	params := struct {
+		Symbol string `json:"symbol"`
+	}{Symbol: symbol}
+
+	var info *t.SymbolInfo
+	err = c.ApiRequest("GET", "/market/udf/symbols", "", false, false, params, &info, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	if info.Status == "error" {
+		return nil, &APIError{
+			GoNobitexError: GoNobitexError{Message: info.ErrMsg},
+			Status:         info.Status,
+			Message:        info.ErrMsg,
+			StatusCode:     http.StatusOK,
+		}
+	}
+	return info, nil
+}
@@ -0,0 +1,201 @@
+// Package udf serves the TradingView Universal Data Feed (UDF) protocol
+// on top of a Nobitex client, so the TradingView charting library can be
+// pointed at a self-hosted service.
+package udf
+
+import (
+	"encoding/json"
+	"net/http"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	nobitex "github.com/darhelm/go-nobitex"
+	t "github.com/darhelm/go-nobitex/types"
+)
+
+// DefaultPriceScale is the price scale reported for symbols without an
+// explicit SymbolInfo (two decimals).
+const DefaultPriceScale = 100
+
+// CandleSource provides candle history. *nobitex.Client implements it.
+type CandleSource interface {
+	GetCandles(params t.GetCandlesParams, opts ...nobitex.RequestOption) (*t.CandleHistory, error)
+}
+
+// SymbolInfo is the UDF symbol description returned from /symbols.
+type SymbolInfo = t.SymbolInfo
+
+// SymbolSource provides symbol metadata. *nobitex.Client implements it.
+type SymbolSource interface {
+	GetSymbolInfo(symbol string, opts ...nobitex.RequestOption) (*t.SymbolInfo, error)
+}
+
+// SymbolsFrom adapts a SymbolSource for Handler.Symbols, so /symbols
+// reports the metadata Nobitex publishes. Lookup failures fall back to
+// DefaultSymbolInfo.
+//
+// Example:
+//
+//	handler := &udf.Handler{Source: client, Symbols: udf.SymbolsFrom(client)}
+func SymbolsFrom(source SymbolSource) func(symbol string) (SymbolInfo, bool) {
+	return func(symbol string) (SymbolInfo, bool) {
+		info, err := source.GetSymbolInfo(symbol)
+		if err != nil || info == nil {
+			return SymbolInfo{}, false
+		}
+		return *info, true
+	}
+}
+
+// DefaultSymbolInfo describes a Nobitex market with generic settings:
+// round-the-clock crypto session in Tehran time and every resolution.
+func DefaultSymbolInfo(symbol string) SymbolInfo {
+	symbol = strings.ToUpper(symbol)
+	return SymbolInfo{
+		Name:                 symbol,
+		Ticker:               symbol,
+		Description:          symbol,
+		Type:                 "crypto",
+		Session:              "24x7",
+		Timezone:             "Asia/Tehran",
+		Exchange:             "Nobitex",
+		ListedExchange:       "Nobitex",
+		MinMov:               1,
+		PriceScale:           DefaultPriceScale,
+		HasIntraday:          true,
+		HasDaily:             true,
+		SupportedResolutions: t.Resolutions,
+		VolumePrecision:      8,
+		DataStatus:           "streaming",
+	}
+}
+
+// Handler implements the UDF endpoints /config, /time, /symbols and
+// /history. Mount it under any prefix; only the last path element is
+// used for routing.
+//
+// Example:
+//
+//	handler := &udf.Handler{Source: client}
+//	http.Handle("/udf/", http.StripPrefix("/udf", handler))
+//	// TradingView datafeed URL: https://example.com/udf
+type Handler struct {
+	// Source fetches candle history.
+	Source CandleSource
+
+	// Symbols resolves symbol metadata. Nil, or a false result, falls back
+	// to DefaultSymbolInfo.
+	Symbols func(symbol string) (SymbolInfo, bool)
+}
+
+// config is the /config response.
+type config struct {
+	SupportedResolutions   []string `json:"supported_resolutions"`
+	SupportsGroupRequest   bool     `json:"supports_group_request"`
+	SupportsMarks          bool     `json:"supports_marks"`
+	SupportsSearch         bool     `json:"supports_search"`
+	SupportsTimescaleMarks bool     `json:"supports_timescale_marks"`
+	SupportsTime           bool     `json:"supports_time"`
+}
+
+// ServeHTTP dispatches a UDF request.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	switch path.Base(r.URL.Path) {
+	case "config":
+		writeJSON(w, config{
+			SupportedResolutions: t.Resolutions,
+			SupportsTime:         true,
+		})
+	case "time":
+		w.Header().Set("Content-Type", "text/plain")
+		_, _ = w.Write([]byte(strconv.FormatInt(time.Now().Unix(), 10)))
+	case "symbols":
+		h.serveSymbol(w, r)
+	case "history":
+		h.serveHistory(w, r)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+// serveSymbol answers /symbols?symbol=BTCIRT.
+func (h *Handler) serveSymbol(w http.ResponseWriter, r *http.Request) {
+	symbol := r.URL.Query().Get("symbol")
+	if symbol == "" {
+		http.Error(w, "missing symbol", http.StatusBadRequest)
+		return
+	}
+
+	// TradingView may send "EXCHANGE:SYMBOL"
+	if _, name, ok := strings.Cut(symbol, ":"); ok {
+		symbol = name
+	}
+
+	if h.Symbols != nil {
+		if info, ok := h.Symbols(symbol); ok {
+			writeJSON(w, info)
+			return
+		}
+	}
+	writeJSON(w, DefaultSymbolInfo(symbol))
+}
+
+// serveHistory answers /history?symbol=&resolution=&from=&to=&countback=.
+// Errors are reported in the UDF envelope ({"s":"error"}) the charting
+// library expects.
+func (h *Handler) serveHistory(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	params := t.GetCandlesParams{
+		Symbol:     strings.ToUpper(query.Get("symbol")),
+		Resolution: query.Get("resolution"),
+	}
+	if _, name, ok := strings.Cut(params.Symbol, ":"); ok {
+		params.Symbol = name
+	}
+
+	var err error
+	if params.From, err = parseInt(query.Get("from")); err == nil {
+		if params.To, err = parseInt(query.Get("to")); err == nil {
+			var countBack int64
+			countBack, err = parseInt(query.Get("countback"))
+			params.CountBack = int(countBack)
+		}
+	}
+	if err != nil || params.Symbol == "" || params.Resolution == "" {
+		writeJSON(w, t.CandleHistory{Status: "error", ErrMsg: "invalid history request"})
+		return
+	}
+	if params.To == 0 {
+		params.To = time.Now().Unix()
+	}
+
+	history, err := h.Source.GetCandles(params, nobitex.WithContext(r.Context()))
+	if err != nil {
+		writeJSON(w, t.CandleHistory{Status: "error", ErrMsg: err.Error()})
+		return
+	}
+	writeJSON(w, history)
+}
+
+// parseInt parses an optional integer query value.
+func parseInt(value string) (int64, error) {
+	if value == "" {
+		return 0, nil
+	}
+	return strconv.ParseInt(value, 10, 64)
+}
+
+// writeJSON encodes v as the response body.
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
@@ -30,3 +30,14 @@ func GenerateOtpCode(otpSecret string) (string, error) {
 
 	return code, nil
 }
+
+// GenerateOtpCodeAt is like GenerateOtpCode but computes the code for the
+// given instant, e.g. the server time estimated from a known clock offset.
+func GenerateOtpCodeAt(otpSecret string, at time.Time) (string, error) {
+	code, err := totp.GenerateCode(otpSecret, at)
+	if err != nil {
+		return "", fmt.Errorf("could not generate otp code: %v", err)
+	}
+
+	return code, nil
+}
@@ -0,0 +1,100 @@
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// DecodeObjectFields incrementally decodes a top-level JSON object from r,
+// invoking fn once per key with a decoder positioned at that key's value.
+//
+// Parameters:
+//
+//	r  — the reader holding a JSON object, typically an HTTP response body.
+//	fn — callback receiving the key and the decoder. It MUST consume exactly
+//	     one JSON value from dec (e.g. via dec.Decode or DecodeArrayElements).
+//
+// Returns:
+//
+//	An error if the payload is not a JSON object, if decoding fails, or if
+//	fn returns an error (which is returned unchanged).
+//
+// Unlike json.Unmarshal, the object is never held in memory as a whole,
+// which keeps peak memory flat for very large responses.
+func DecodeObjectFields(r io.Reader, fn func(key string, dec *json.Decoder) error) error {
+	dec := json.NewDecoder(r)
+
+	if err := expectDelim(dec, '{'); err != nil {
+		return err
+	}
+
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+
+		key, ok := tok.(string)
+		if !ok {
+			return fmt.Errorf("expected object key, got %v", tok)
+		}
+
+		if err := fn(key, dec); err != nil {
+			return err
+		}
+	}
+
+	return expectDelim(dec, '}')
+}
+
+// DecodeArrayElements decodes a JSON array from dec one element at a time,
+// passing each decoded element to fn.
+//
+// Parameters:
+//
+//	dec — a decoder positioned at the start of a JSON array.
+//	fn  — callback invoked for every element, in order.
+//
+// Returns:
+//
+//	An error if the next value is not an array, an element fails to decode,
+//	or fn returns an error. Iteration stops at the first error.
+func DecodeArrayElements[T any](dec *json.Decoder, fn func(T) error) error {
+	if err := expectDelim(dec, '['); err != nil {
+		return err
+	}
+
+	for dec.More() {
+		var item T
+		if err := dec.Decode(&item); err != nil {
+			return err
+		}
+		if err := fn(item); err != nil {
+			return err
+		}
+	}
+
+	return expectDelim(dec, ']')
+}
+
+// SkipValue consumes and discards the next JSON value from dec.
+func SkipValue(dec *json.Decoder) error {
+	var skip json.RawMessage
+	return dec.Decode(&skip)
+}
+
+// expectDelim reads the next token from dec and verifies it is the
+// given delimiter.
+func expectDelim(dec *json.Decoder, want json.Delim) error {
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+
+	if d, ok := tok.(json.Delim); !ok || d != want {
+		return fmt.Errorf("expected %q, got %v", want, tok)
+	}
+
+	return nil
+}
@@ -5,23 +5,61 @@ import (
 	"net/url"
 	"reflect"
 	"strconv"
+	"strings"
+	"time"
 )
 
+var timeType = reflect.TypeOf(time.Time{})
+
+// URLParamEncoder is implemented by types that control their own query
+// string representation instead of relying on reflection.
+//
+// EncodeURLParams receives the parameter key the value is stored under
+// (empty when the type itself is passed to StructToURLParams) and adds any
+// number of entries to values. Returning an error aborts the encoding.
+//
+// Example:
+//
+//	type Range struct{ From, To int64 }
+//
+//	func (r Range) EncodeURLParams(key string, values url.Values) error {
+//	    if r.From > 0 {
+//	        values.Set(key+"From", strconv.FormatInt(r.From, 10))
+//	    }
+//	    if r.To > 0 {
+//	        values.Set(key+"To", strconv.FormatInt(r.To, 10))
+//	    }
+//	    return nil
+//	}
+type URLParamEncoder interface {
+	EncodeURLParams(key string, values url.Values) error
+}
+
 // StructToURLParams converts a struct to a URL-encoded query string.
 //
 // This function uses the `json` struct tags as parameter keys and excludes
 // fields with empty or zero values. It supports various data types, including
-// slices, arrays, integers, floats, booleans, and strings.
+// slices, arrays, pointers, time.Time, integers, floats, booleans, and strings.
 //
 // Supported Behavior:
-//   - Fields with `json` tags are used as keys. Fields without tags or with
+//   - Fields with `json` tags are used as keys; tag options such as
+//     ",omitempty" are stripped from the key. Fields without tags or with
 //     `json:"-"` are ignored.
-//   - Zero values (e.g., empty strings, 0 for integers, 0.0 for floats) are omitted.
-//   - Slices and arrays are converted to multiple key-value pairs.
+//   - Zero values (e.g., empty strings, 0 for integers, 0.0 for floats,
+//     false, empty slices, the zero time.Time) are omitted.
+//   - Pointers are dereferenced. A nil pointer is omitted, while a non-nil
+//     pointer is always sent, which allows explicit zero values such as
+//     `details=0` to be requested.
+//   - Slices and arrays are sent as a single comma-separated value
+//     (e.g. currencies=btc,usdt), the list format Nobitex expects.
+//   - time.Time values are sent as Unix timestamps in seconds.
+//   - Untagged embedded structs are flattened into the parent; tagged nested
+//     structs are encoded with bracketed keys (e.g. range[from]=1).
+//   - Values implementing URLParamEncoder encode themselves.
 //
 // Parameters:
-//   - inputStruct: The input struct to be converted into URL parameters. It
-//     must be of kind `struct`.
+//   - inputStruct: The input struct (or pointer to struct) to be converted
+//     into URL parameters.
 //
 // Returns:
 //   - A URL-encoded query string as a `string`.
@@ -31,14 +69,13 @@ import (
 //
 //	type MyStruct struct {
 //	    Name    string   `json:"name"`
-//	    Age     int      `json:"age"`
+//	    Age     int      `json:"age,omitempty"`
 //	    Tags    []string `json:"tags"`
 //	    IsAdmin bool     `json:"is_admin"`
 //	}
 //
 //	data := MyStruct{
 //	    Name:    "John",
-//	    Age:     30,
 //	    Tags:    []string{"golang", "developer"},
 //	    IsAdmin: true,
 //	}
@@ -48,64 +85,250 @@ import (
 //	    log.Fatal(err)
 //	}
 //	fmt.Println(query)
-//	// Output: name=John&age=30&tags=golang&tags=developer&is_admin=true
+//	// Output: is_admin=true&name=John&tags=golang%2Cdeveloper
 //
 // Limitations:
-//   - Only fields with `json` tags are considered.
+//   - Only fields with `json` tags (or untagged embedded structs) are considered.
+//   - Maps are not supported; wrap them in a URLParamEncoder instead.
 //   - Non-struct input will result in an error.
 func StructToURLParams(inputStruct interface{}) (string, error) {
 	values := url.Values{}
 
-	// Get the type and value of the input struct
+	// Types that encode themselves take full control of the output
+	if encoder, ok := inputStruct.(URLParamEncoder); ok {
+		if err := encoder.EncodeURLParams("", values); err != nil {
+			return "", err
+		}
+		return values.Encode(), nil
+	}
+
+	// Get the value of the input struct, looking through pointers
 	v := reflect.ValueOf(inputStruct)
-	t := reflect.TypeOf(inputStruct)
+	for v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return "", nil
+		}
+		v = v.Elem()
+	}
 
 	// Ensure the input is a struct
-	if t.Kind() != reflect.Struct {
+	if v.Kind() != reflect.Struct {
 		return "", fmt.Errorf("input must be a struct")
 	}
 
+	if err := encodeStruct(v, "", values); err != nil {
+		return "", err
+	}
+
+	// Encode and return the URL parameters
+	return values.Encode(), nil
+}
+
+// encodeStruct adds the fields of the struct value v to values, prefixing
+// every key with prefix (used for nested structs).
+func encodeStruct(v reflect.Value, prefix string, values url.Values) error {
+	t := v.Type()
+
 	// Iterate through the struct fields
 	for i := 0; i < v.NumField(); i++ {
 		field := t.Field(i)
 		value := v.Field(i)
 
-		// Use the "json" tag if available; otherwise, use the field name
-		key := field.Tag.Get("json")
-		if key == "" || key == "-" {
+		// Untagged embedded structs are flattened into the parent,
+		// mirroring encoding/json field promotion
+		if field.Anonymous && field.Tag.Get("json") == "" {
+			embedded := value
+			if embedded.Kind() == reflect.Pointer {
+				if embedded.IsNil() {
+					continue
+				}
+				embedded = embedded.Elem()
+			}
+			if embedded.Kind() == reflect.Struct {
+				if err := encodeStruct(embedded, prefix, values); err != nil {
+					return err
+				}
+				continue
+			}
+		}
+
+		if !field.IsExported() {
+			continue
+		}
+
+		key, ok := paramKey(field)
+		if !ok {
 			continue // Skip fields without a "json" tag or explicitly ignored
 		}
+		if prefix != "" {
+			key = prefix + "[" + key + "]"
+		}
 
-		// Skip zero values
-		if !value.IsValid() || value.IsZero() {
+		// Custom encoders decide for themselves, including for zero values
+		if encoder, ok := asEncoder(value); ok {
+			if err := encoder.EncodeURLParams(key, values); err != nil {
+				return fmt.Errorf("field %s: %w", field.Name, err)
+			}
 			continue
 		}
 
-		// Handle different kinds of fields
-		switch value.Kind() {
-		case reflect.Slice, reflect.Array:
-			if value.Len() > 0 { // Only add non-empty slices/arrays
-				for j := 0; j < value.Len(); j++ {
-					values.Add(key, fmt.Sprintf("%v", value.Index(j).Interface()))
-				}
+		// A set pointer is always sent, even when it points to a zero value
+		explicit := false
+		for value.Kind() == reflect.Pointer {
+			if value.IsNil() {
+				break
 			}
-		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
-			if value.Int() != 0 { // Skip zero values
-				values.Add(key, strconv.FormatInt(value.Int(), 10))
+			value = value.Elem()
+			explicit = true
+		}
+
+		// Skip nil pointers and zero values
+		if !value.IsValid() || (value.Kind() == reflect.Pointer && value.IsNil()) {
+			continue
+		}
+		if !explicit && value.IsZero() {
+			continue
+		}
+
+		// Nested structs contribute their own fields as key[child]
+		if value.Kind() == reflect.Struct && value.Type() != timeType {
+			if err := encodeStruct(value, key, values); err != nil {
+				return err
 			}
-		case reflect.Float32, reflect.Float64:
-			if value.Float() != 0 { // Skip zero values
-				values.Add(key, strconv.FormatFloat(value.Float(), 'f', -1, 64))
+			continue
+		}
+
+		encoded, err := formatParam(value)
+		if err != nil {
+			return fmt.Errorf("field %s: %w", field.Name, err)
+		}
+		if encoded == "" && !explicit {
+			continue // e.g. an empty slice
+		}
+		values.Add(key, encoded)
+	}
+
+	return nil
+}
+
+// asEncoder reports whether value, or a pointer to it, implements
+// URLParamEncoder. Nil pointers and read-only values never qualify.
+func asEncoder(value reflect.Value) (URLParamEncoder, bool) {
+	if value.Kind() == reflect.Pointer && value.IsNil() {
+		return nil, false
+	}
+	// Fields promoted from an unexported embedded struct are read-only
+	// and cannot be turned back into interfaces
+	if !value.CanInterface() {
+		return nil, false
+	}
+	if encoder, ok := value.Interface().(URLParamEncoder); ok {
+		return encoder, true
+	}
+	if value.CanAddr() {
+		if encoder, ok := value.Addr().Interface().(URLParamEncoder); ok {
+			return encoder, true
+		}
+	}
+	return nil, false
+}
+
+// ToURLParams converts a GET request body into a URL-encoded query string.
+//
+// Besides structs (handled by StructToURLParams) it accepts the raw forms
+// that are convenient for one-off or not-yet-typed endpoints:
+//   - url.Values and map[string][]string, encoded as-is.
+//   - map[string]string, one value per key.
+//
+// Parameters:
+//   - input: A struct, pointer to struct, url.Values, map[string][]string or
+//     map[string]string.
+//
+// Returns:
+//   - A URL-encoded query string (keys sorted), possibly empty.
+//   - An `error` if input is of an unsupported type.
+//
+// Example:
+//
+//	query, _ := ToURLParams(map[string]string{"symbol": "BTCIRT"})
+//	// query == "symbol=BTCIRT"
+func ToURLParams(input interface{}) (string, error) {
+	switch params := input.(type) {
+	case url.Values:
+		return params.Encode(), nil
+	case map[string][]string:
+		return url.Values(params).Encode(), nil
+	case map[string]string:
+		values := url.Values{}
+		for key, value := range params {
+			values.Set(key, value)
+		}
+		return values.Encode(), nil
+	default:
+		return StructToURLParams(input)
+	}
+}
+
+// paramKey extracts the query parameter name from a field's json tag.
+// It reports false for untagged or explicitly ignored fields.
+func paramKey(field reflect.StructField) (string, bool) {
+	tag := field.Tag.Get("json")
+	if tag == "" || tag == "-" {
+		return "", false
+	}
+
+	name, _, _ := strings.Cut(tag, ",")
+	if name == "" {
+		name = field.Name
+	}
+	return name, true
+}
+
+// formatParam renders a single non-pointer value as a query parameter value.
+func formatParam(value reflect.Value) (string, error) {
+	if value.Type() == timeType {
+		if !value.CanInterface() {
+			return "", fmt.Errorf("time.Time promoted from an unexported embedded struct cannot be read; export the embedded type")
+		}
+		return strconv.FormatInt(value.Interface().(time.Time).Unix(), 10), nil
+	}
+
+	switch value.Kind() {
+	case reflect.Slice, reflect.Array:
+		parts := make([]string, 0, value.Len())
+		for j := 0; j < value.Len(); j++ {
+			item := value.Index(j)
+			for item.Kind() == reflect.Pointer && !item.IsNil() {
+				item = item.Elem()
 			}
-		case reflect.Bool:
-			values.Add(key, strconv.FormatBool(value.Bool())) // Always add booleans
-		default:
-			if value.String() != "" { // Skip empty strings
-				values.Add(key, fmt.Sprintf("%v", value.Interface()))
+			part, err := formatParam(item)
+			if err != nil {
+				return "", err
 			}
+			parts = append(parts, part)
 		}
+		return strings.Join(parts, ","), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(value.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(value.Uint(), 10), nil
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(value.Float(), 'f', -1, 64), nil
+	case reflect.Bool:
+		return strconv.FormatBool(value.Bool()), nil
+	case reflect.String:
+		return value.String(), nil
+	case reflect.Pointer, reflect.Interface:
+		if value.IsNil() {
+			return "", nil
+		}
+		return formatParam(value.Elem())
+	case reflect.Map, reflect.Struct, reflect.Func, reflect.Chan:
+		return "", fmt.Errorf("unsupported parameter type %s", value.Type())
+	default:
+		if !value.CanInterface() {
+			return "", fmt.Errorf("unsupported parameter type %s", value.Type())
+		}
+		return fmt.Sprintf("%v", value.Interface()), nil
 	}
-
-	// Encode and return the URL parameters
-	return values.Encode(), nil
 }
@@ -0,0 +1,170 @@
+package utils
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+type paging struct {
+	Page     int `json:"page,omitempty"`
+	PageSize int `json:"pageSize,omitempty"`
+}
+
+type Paging struct {
+	Page int `json:"page,omitempty"`
+}
+
+type window struct {
+	From int64 `json:"from"`
+	To   int64 `json:"to"`
+}
+
+func (w window) EncodeURLParams(key string, values url.Values) error {
+	values.Set(key+"From", "x")
+	return nil
+}
+
+type encodedParams struct {
+	Range window `json:"range"`
+}
+
+func TestStructToURLParams(t *testing.T) {
+	zero := 0
+
+	tests := []struct {
+		name  string
+		input any
+		want  string
+	}{
+		{
+			name: "tags and omitted zero values",
+			input: struct {
+				Symbol string `json:"symbol"`
+				Limit  int    `json:"limit,omitempty"`
+				Hidden string `json:"-"`
+				NoTag  string
+			}{Symbol: "BTCIRT", Hidden: "h", NoTag: "n"},
+			want: "symbol=BTCIRT",
+		},
+		{
+			name: "explicit zero pointer is sent",
+			input: struct {
+				Details *int `json:"details"`
+				Unset   *int `json:"unset"`
+			}{Details: &zero},
+			want: "details=0",
+		},
+		{
+			name: "slices are comma separated",
+			input: struct {
+				Currencies []string `json:"currencies"`
+				Empty      []string `json:"empty"`
+			}{Currencies: []string{"btc", "usdt"}},
+			want: "currencies=btc%2Cusdt",
+		},
+		{
+			name: "time is unix seconds",
+			input: struct {
+				From time.Time `json:"from"`
+			}{From: time.Unix(1700000000, 0)},
+			want: "from=1700000000",
+		},
+		{
+			name: "nested struct uses bracketed keys",
+			input: struct {
+				Range struct {
+					From int `json:"from"`
+				} `json:"range"`
+			}{Range: struct {
+				From int `json:"from"`
+			}{From: 1}},
+			want: "range%5Bfrom%5D=1",
+		},
+		{
+			name: "exported embedded struct is flattened",
+			input: struct {
+				Paging
+				Symbol string `json:"symbol"`
+			}{Paging: Paging{Page: 2}, Symbol: "BTCIRT"},
+			want: "page=2&symbol=BTCIRT",
+		},
+		{
+			name: "unexported embedded struct is flattened",
+			input: struct {
+				paging
+				Symbol string `json:"symbol"`
+			}{paging: paging{Page: 2, PageSize: 50}, Symbol: "BTCIRT"},
+			want: "page=2&pageSize=50&symbol=BTCIRT",
+		},
+		{
+			name: "unexported embedded pointer is flattened",
+			input: struct {
+				*paging
+				Symbol string `json:"symbol"`
+			}{paging: &paging{PageSize: 10}, Symbol: "BTCIRT"},
+			want: "pageSize=10&symbol=BTCIRT",
+		},
+		{
+			name: "pointer input",
+			input: &struct {
+				Symbol string `json:"symbol"`
+			}{Symbol: "ETHUSDT"},
+			want: "symbol=ETHUSDT",
+		},
+		{
+			name:  "custom encoder",
+			input: encodedParams{Range: window{From: 1}},
+			want:  "rangeFrom=x",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := StructToURLParams(tt.input)
+			if err != nil {
+				t.Fatalf("StructToURLParams: %v", err)
+			}
+			if got != tt.want {
+				t.Fatalf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStructToURLParamsErrors(t *testing.T) {
+	if _, err := StructToURLParams(42); err == nil {
+		t.Fatal("expected an error for non-struct input")
+	}
+
+	_, err := StructToURLParams(struct {
+		Filters map[string]string `json:"filters"`
+	}{Filters: map[string]string{"a": "b"}})
+	if err == nil || !strings.Contains(err.Error(), "unsupported") {
+		t.Fatalf("map field error = %v, want unsupported parameter type", err)
+	}
+}
+
+func TestToURLParams(t *testing.T) {
+	tests := []struct {
+		name  string
+		input any
+		want  string
+	}{
+		{"url.Values", url.Values{"a": {"1", "2"}}, "a=1&a=2"},
+		{"map of slices", map[string][]string{"b": {"x"}}, "b=x"},
+		{"map of strings", map[string]string{"symbol": "BTCIRT"}, "symbol=BTCIRT"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ToURLParams(tt.input)
+			if err != nil {
+				t.Fatalf("ToURLParams: %v", err)
+			}
+			if got != tt.want {
+				t.Fatalf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,126 @@
+package nobitex
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// OptionsError lists every problem found by ClientOptions.Validate.
+type OptionsError struct {
+	Problems []error
+}
+
+func (e *OptionsError) Error() string {
+	lines := make([]string, len(e.Problems))
+	for i, problem := range e.Problems {
+		lines[i] = "  - " + problem.Error()
+	}
+	return fmt.Sprintf("invalid client options (%d problems):\n%s", len(e.Problems), strings.Join(lines, "\n"))
+}
+
+// Unwrap exposes the individual problems to errors.Is and errors.As.
+func (e *OptionsError) Unwrap() []error { return e.Problems }
+
+// Validate checks opts for combinations that would otherwise only fail
+// at the first authenticated request or token refresh. NewClient calls it
+// before doing anything else.
+//
+// Returns:
+//   - nil when opts are consistent.
+//   - *OptionsError listing every problem, not just the first.
+//
+// Example:
+//
+//	if err := opts.Validate(); err != nil {
+//	    log.Fatal(err) // prints one line per problem
+//	}
+func (opts ClientOptions) Validate() error {
+	var problems []error
+	add := func(format string, args ...any) {
+		problems = append(problems, &GoNobitexError{Message: fmt.Sprintf(format, args...)})
+	}
+
+	if !opts.Remember.Valid() {
+		add("Remember is %q, want t.RememberYes or t.RememberNo", opts.Remember)
+	}
+
+	if (opts.Username == "") != (opts.Password == "") {
+		add("Username and Password must be set together")
+	}
+	if opts.ApiKey != "" && opts.Username != "" && !opts.AutoRefresh {
+		add("ApiKey and Username/Password are both set; drop one, or enable AutoRefresh to log in again when the key expires")
+	}
+	if opts.ApiKey == "" && opts.Username != "" && opts.OtpSecret == "" && opts.OtpCode == "" {
+		add("logging in with Username/Password requires OtpSecret or OtpCode")
+	}
+	if opts.AutoRefresh && opts.OtpSecret == "" {
+		add("AutoRefresh requires OtpSecret to generate login codes")
+	}
+	if opts.AutoRefresh && opts.Username == "" {
+		add("AutoRefresh requires Username and Password to log in again")
+	}
+	if opts.AutoAuth && opts.ApiKey == "" && opts.Username == "" {
+		add("AutoAuth requires Username and Password")
+	}
+
+	if strings.ContainsAny(opts.UserAgent, "\r\n") {
+		add("UserAgent must not contain line breaks")
+	}
+
+	if opts.Timeout < 0 {
+		add("Timeout is negative (%s)", opts.Timeout)
+	}
+	if opts.HttpClient != nil && opts.Timeout != 0 {
+		add("Timeout is ignored when HttpClient is set; configure HttpClient.Timeout instead")
+	}
+
+	if opts.Testnet && (opts.BaseUrl != "" || len(opts.BaseUrls) > 0) {
+		add("Testnet cannot be combined with BaseUrl or BaseUrls")
+	}
+
+	for _, base := range append([]string{opts.BaseUrl}, opts.BaseUrls...) {
+		if base == "" {
+			continue
+		}
+		if u, err := url.Parse(base); err != nil || u.Scheme == "" || u.Host == "" {
+			add("base URL %q is not an absolute URL", base)
+		}
+	}
+
+	durations := []struct {
+		name  string
+		value time.Duration
+	}{
+		{"FailoverCooldown", opts.FailoverCooldown},
+		{"ConfigTTL", opts.ConfigTTL},
+		{"MarketDataCacheTTL", opts.MarketDataCacheTTL},
+		{"MaintenanceProbeInterval", opts.MaintenanceProbeInterval},
+		{"Retry.InitialBackoff", opts.Retry.InitialBackoff},
+		{"Retry.MaxBackoff", opts.Retry.MaxBackoff},
+		{"Retry.MaxElapsedTime", opts.Retry.MaxElapsedTime},
+	}
+	for _, d := range durations {
+		if d.value < 0 {
+			add("%s is negative", d.name)
+		}
+	}
+
+	if opts.MaxConcurrentRequests < 0 {
+		add("MaxConcurrentRequests is negative (%d)", opts.MaxConcurrentRequests)
+	}
+	if opts.Retry.MaxAttempts < 0 {
+		add("Retry.MaxAttempts is negative (%d)", opts.Retry.MaxAttempts)
+	}
+	for family, limit := range opts.RateLimits {
+		if limit.Requests <= 0 || limit.Per <= 0 {
+			add("RateLimits[%s] needs positive Requests and Per", family)
+		}
+	}
+
+	if len(problems) == 0 {
+		return nil
+	}
+	return &OptionsError{Problems: problems}
+}
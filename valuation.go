@@ -0,0 +1,169 @@
+package nobitex
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+
+	t "github.com/darhelm/go-nobitex/types"
+)
+
+// valuationBridges are the currencies tried, in order, to price an asset
+// that has no market against the requested quote currency.
+var valuationBridges = []string{"usdt", "rls"}
+
+// PortfolioValue values every non-empty wallet in quote using the latest
+// ticker prices, and reports each asset's share of the total.
+//
+// Parameters:
+//   - ctx: Context of the wallet and ticker requests.
+//   - quote: "usdt", "rls" for rial values or "irt" for toman values.
+//     Nobitex quotes rial markets in rials; toman values are the rial
+//     values divided by 10.
+//
+// Returns:
+//   - *t.PortfolioValuation with the total and per-asset values.
+//   - An error if quote is unsupported or a request fails.
+//
+// Behavior:
+//   - Blocked funds are included in each wallet's amount.
+//   - A direct market is preferred, then the inverse market, then a
+//     two-leg path through USDT or rial; closed markets are skipped.
+//   - Assets without any price path are listed in Unpriced instead of
+//     failing the call.
+//
+// Example:
+//
+//	v, err := client.PortfolioValue(ctx, "usdt")
+//	if err != nil {
+//	    return err
+//	}
+//	for _, a := range v.Assets {
+//	    fmt.Printf("%s %s (%.1f%%)\n", a.Currency, a.Value.FloatString(2), a.Weight*100)
+//	}
+func (c *Client) PortfolioValue(ctx context.Context, quote string, opts ...RequestOption) (*t.PortfolioValuation, error) {
+	quote = strings.ToLower(quote)
+	toman := quote == "irt"
+	if toman {
+		quote = "rls"
+	}
+	if quote != "usdt" && quote != "rls" {
+		return nil, &GoNobitexError{Message: fmt.Sprintf("unsupported quote currency %q, use usdt, rls or irt", quote)}
+	}
+
+	opts = append([]RequestOption{WithContext(ctx)}, opts...)
+	wallets, err := c.GetWallets(t.GetWalletParams{}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	tickers, err := c.GetTickers(t.GetTickersParams{}, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	valuation := &t.PortfolioValuation{Quote: quote, Total: new(big.Rat)}
+	for currency, wallet := range wallets.Wallets {
+		currency = strings.ToLower(currency)
+		balance, err := parseBalance(currency, wallet)
+		if err != nil {
+			return nil, err
+		}
+		if balance.Total.Sign() <= 0 {
+			continue
+		}
+
+		price, path, ok := crossPrice(tickers, currency, quote)
+		if !ok {
+			valuation.Unpriced = append(valuation.Unpriced, currency)
+			continue
+		}
+
+		value := new(big.Rat).Mul(balance.Total, price)
+		valuation.Total.Add(valuation.Total, value)
+		valuation.Assets = append(valuation.Assets, t.AssetValue{
+			Currency: currency,
+			Amount:   balance.Total,
+			Price:    price,
+			Value:    value,
+			Path:     path,
+		})
+	}
+
+	if toman {
+		tenth := big.NewRat(1, 10)
+		valuation.Quote = "irt"
+		valuation.Total.Mul(valuation.Total, tenth)
+		for i := range valuation.Assets {
+			valuation.Assets[i].Price.Mul(valuation.Assets[i].Price, tenth)
+			valuation.Assets[i].Value.Mul(valuation.Assets[i].Value, tenth)
+		}
+	}
+	if valuation.Total.Sign() > 0 {
+		for i := range valuation.Assets {
+			weight, _ := new(big.Rat).Quo(valuation.Assets[i].Value, valuation.Total).Float64()
+			valuation.Assets[i].Weight = weight
+		}
+	}
+	sort.Slice(valuation.Assets, func(i, j int) bool {
+		return valuation.Assets[i].Value.Cmp(valuation.Assets[j].Value) > 0
+	})
+	sort.Strings(valuation.Unpriced)
+	return valuation, nil
+}
+
+// crossPrice returns the price of one unit of src in dst and the path it
+// was derived through.
+func crossPrice(tickers *t.Tickers, src, dst string) (*big.Rat, []string, bool) {
+	if src == dst {
+		return big.NewRat(1, 1), []string{src}, true
+	}
+	if price, ok := pairPrice(tickers, src, dst); ok {
+		return price, []string{src, dst}, true
+	}
+
+	for _, bridge := range valuationBridges {
+		if bridge == src || bridge == dst {
+			continue
+		}
+		first, ok := pairPrice(tickers, src, bridge)
+		if !ok {
+			continue
+		}
+		second, ok := pairPrice(tickers, bridge, dst)
+		if !ok {
+			continue
+		}
+		return new(big.Rat).Mul(first, second), []string{src, bridge, dst}, true
+	}
+	return nil, nil, false
+}
+
+// pairPrice returns the direct or inverted price between two currencies
+// from markets keyed "src-dst".
+func pairPrice(tickers *t.Tickers, src, dst string) (*big.Rat, bool) {
+	if price, ok := LatestPrice(tickers, src+"-"+dst); ok {
+		return price, true
+	}
+	if price, ok := LatestPrice(tickers, dst+"-"+src); ok {
+		return new(big.Rat).Inv(price), true
+	}
+	return nil, false
+}
+
+// LatestPrice returns the last positive price of an open market from a
+// GetTickers response, keyed "src-dst" as in t.Tickers.Stats, e.g.
+// "btc-usdt".
+func LatestPrice(tickers *t.Tickers, market string) (*big.Rat, bool) {
+	ticker, ok := tickers.Stats[market]
+	if !ok || ticker.IsClosed {
+		return nil, false
+	}
+
+	price, ok := new(big.Rat).SetString(ticker.Latest)
+	if !ok || price.Sign() <= 0 {
+		return nil, false
+	}
+	return price, true
+}
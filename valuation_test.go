@@ -0,0 +1,42 @@
+package nobitex
+
+import (
+	"context"
+	"math/big"
+	"net/http"
+	"testing"
+)
+
+func TestPortfolioValueInToman(t *testing.T) {
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v2/wallets":
+			_, _ = w.Write([]byte(`{"status":"ok","wallets":{"BTC":{"balance":"0.5"},"RLS":{"balance":"1000"}}}`))
+		case "/market/stats":
+			_, _ = w.Write([]byte(`{"status":"ok","stats":{"btc-rls":{"latest":"60000000000"}}}`))
+		}
+	}), ClientOptions{})
+
+	rials, err := c.PortfolioValue(context.Background(), "rls")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tomans, err := c.PortfolioValue(context.Background(), "irt")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if tomans.Quote != "irt" {
+		t.Fatalf("Quote = %q, want irt", tomans.Quote)
+	}
+	want := new(big.Rat).Quo(rials.Total, big.NewRat(10, 1))
+	if tomans.Total.Cmp(want) != 0 {
+		t.Fatalf("toman total = %s, want %s", tomans.Total.RatString(), want.RatString())
+	}
+	if got := tomans.Assets[0].Price.RatString(); got != "6000000000" {
+		t.Fatalf("btc price = %s toman, want 6000000000", got)
+	}
+	if tomans.Assets[0].Weight != rials.Assets[0].Weight {
+		t.Fatalf("weights differ: %v toman, %v rial", tomans.Assets[0].Weight, rials.Assets[0].Weight)
+	}
+}